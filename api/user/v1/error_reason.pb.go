@@ -0,0 +1,388 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.19.3
+// source: user/v1/error_reason.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ErrorReason int32
+
+const (
+	ErrorReason_USER_UNSPECIFIED             ErrorReason = 0
+	ErrorReason_USER_NOT_FOUND               ErrorReason = 1
+	ErrorReason_USER_ALREADY_EXISTS          ErrorReason = 2
+	ErrorReason_INVALID_CREDENTIALS          ErrorReason = 3
+	ErrorReason_EMAIL_TAKEN                  ErrorReason = 4
+	ErrorReason_USERNAME_TAKEN               ErrorReason = 5
+	ErrorReason_INVALID_PASSWORD             ErrorReason = 6
+	ErrorReason_UNAUTHENTICATED              ErrorReason = 7
+	ErrorReason_TOKEN_INVALID                ErrorReason = 8
+	ErrorReason_TOKEN_EXPIRED                ErrorReason = 9
+	ErrorReason_PERMISSION_DENIED            ErrorReason = 10
+	ErrorReason_REFRESH_TOKEN_INVALID        ErrorReason = 11
+	ErrorReason_SESSION_NOT_FOUND            ErrorReason = 12
+	ErrorReason_CANNOT_FOLLOW_SELF           ErrorReason = 13
+	ErrorReason_ALREADY_FOLLOWING            ErrorReason = 14
+	ErrorReason_NOT_FOLLOWING                ErrorReason = 15
+	ErrorReason_PAGE_TOKEN_INVALID           ErrorReason = 16
+	ErrorReason_PAGE_SIZE_INVALID            ErrorReason = 17
+	ErrorReason_CANNOT_BLOCK_SELF            ErrorReason = 18
+	ErrorReason_ALREADY_BLOCKED              ErrorReason = 19
+	ErrorReason_NOT_BLOCKED                  ErrorReason = 20
+	ErrorReason_CANNOT_MUTE_SELF             ErrorReason = 21
+	ErrorReason_ALREADY_MUTED                ErrorReason = 22
+	ErrorReason_NOT_MUTED                    ErrorReason = 23
+	ErrorReason_USER_BLOCKED                 ErrorReason = 24
+	ErrorReason_FOLLOW_REQUEST_NOT_FOUND     ErrorReason = 25
+	ErrorReason_ALREADY_REQUESTED            ErrorReason = 26
+	ErrorReason_INVALID_PROFILE              ErrorReason = 27
+	ErrorReason_INVALID_UPDATE_MASK          ErrorReason = 28
+	ErrorReason_VERSION_CONFLICT             ErrorReason = 29
+	ErrorReason_INVALID_HANDLE               ErrorReason = 30
+	ErrorReason_HANDLE_RESERVED              ErrorReason = 31
+	ErrorReason_HANDLE_CHANGE_TOO_SOON       ErrorReason = 32
+	ErrorReason_HANDLE_NOT_FOUND             ErrorReason = 33
+	ErrorReason_BATCH_TOO_LARGE              ErrorReason = 34
+	ErrorReason_VERIFICATION_TOKEN_INVALID   ErrorReason = 35
+	ErrorReason_EMAIL_ALREADY_VERIFIED       ErrorReason = 36
+	ErrorReason_VERIFICATION_TOO_SOON        ErrorReason = 37
+	ErrorReason_PASSWORD_RESET_TOKEN_INVALID ErrorReason = 38
+	ErrorReason_TOTP_NOT_ENABLED             ErrorReason = 39
+	ErrorReason_TOTP_ALREADY_ENABLED         ErrorReason = 40
+	ErrorReason_SECOND_FACTOR_INVALID        ErrorReason = 41
+	ErrorReason_CHALLENGE_INVALID            ErrorReason = 42
+	ErrorReason_SESSION_REVOKED              ErrorReason = 43
+	ErrorReason_OAUTH_CLIENT_NOT_FOUND       ErrorReason = 44
+	ErrorReason_INVALID_OAUTH_CLIENT         ErrorReason = 45
+	ErrorReason_AUTHORIZATION_CODE_INVALID   ErrorReason = 46
+	ErrorReason_IDENTITY_PROVIDER_NOT_FOUND  ErrorReason = 47
+	ErrorReason_FEDERATED_LOGIN_INVALID      ErrorReason = 48
+	ErrorReason_FEDERATED_LOGIN_FAILED       ErrorReason = 49
+	ErrorReason_FEDERATED_EMAIL_UNVERIFIED   ErrorReason = 50
+	ErrorReason_FEDERATED_ACCOUNT_CONFLICT   ErrorReason = 51
+	ErrorReason_LINKED_IDENTITY_NOT_FOUND    ErrorReason = 52
+	ErrorReason_LAST_LOGIN_METHOD            ErrorReason = 53
+	ErrorReason_API_KEY_NOT_FOUND            ErrorReason = 54
+	ErrorReason_INVALID_API_KEY              ErrorReason = 55
+	ErrorReason_API_KEY_LIMIT_REACHED        ErrorReason = 56
+	ErrorReason_INVALID_EMAIL                ErrorReason = 57
+	ErrorReason_SECOND_FACTOR_LOCKED         ErrorReason = 58
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "USER_UNSPECIFIED",
+		1:  "USER_NOT_FOUND",
+		2:  "USER_ALREADY_EXISTS",
+		3:  "INVALID_CREDENTIALS",
+		4:  "EMAIL_TAKEN",
+		5:  "USERNAME_TAKEN",
+		6:  "INVALID_PASSWORD",
+		7:  "UNAUTHENTICATED",
+		8:  "TOKEN_INVALID",
+		9:  "TOKEN_EXPIRED",
+		10: "PERMISSION_DENIED",
+		11: "REFRESH_TOKEN_INVALID",
+		12: "SESSION_NOT_FOUND",
+		13: "CANNOT_FOLLOW_SELF",
+		14: "ALREADY_FOLLOWING",
+		15: "NOT_FOLLOWING",
+		16: "PAGE_TOKEN_INVALID",
+		17: "PAGE_SIZE_INVALID",
+		18: "CANNOT_BLOCK_SELF",
+		19: "ALREADY_BLOCKED",
+		20: "NOT_BLOCKED",
+		21: "CANNOT_MUTE_SELF",
+		22: "ALREADY_MUTED",
+		23: "NOT_MUTED",
+		24: "USER_BLOCKED",
+		25: "FOLLOW_REQUEST_NOT_FOUND",
+		26: "ALREADY_REQUESTED",
+		27: "INVALID_PROFILE",
+		28: "INVALID_UPDATE_MASK",
+		29: "VERSION_CONFLICT",
+		30: "INVALID_HANDLE",
+		31: "HANDLE_RESERVED",
+		32: "HANDLE_CHANGE_TOO_SOON",
+		33: "HANDLE_NOT_FOUND",
+		34: "BATCH_TOO_LARGE",
+		35: "VERIFICATION_TOKEN_INVALID",
+		36: "EMAIL_ALREADY_VERIFIED",
+		37: "VERIFICATION_TOO_SOON",
+		38: "PASSWORD_RESET_TOKEN_INVALID",
+		39: "TOTP_NOT_ENABLED",
+		40: "TOTP_ALREADY_ENABLED",
+		41: "SECOND_FACTOR_INVALID",
+		42: "CHALLENGE_INVALID",
+		43: "SESSION_REVOKED",
+		44: "OAUTH_CLIENT_NOT_FOUND",
+		45: "INVALID_OAUTH_CLIENT",
+		46: "AUTHORIZATION_CODE_INVALID",
+		47: "IDENTITY_PROVIDER_NOT_FOUND",
+		48: "FEDERATED_LOGIN_INVALID",
+		49: "FEDERATED_LOGIN_FAILED",
+		50: "FEDERATED_EMAIL_UNVERIFIED",
+		51: "FEDERATED_ACCOUNT_CONFLICT",
+		52: "LINKED_IDENTITY_NOT_FOUND",
+		53: "LAST_LOGIN_METHOD",
+		54: "API_KEY_NOT_FOUND",
+		55: "INVALID_API_KEY",
+		56: "API_KEY_LIMIT_REACHED",
+		57: "INVALID_EMAIL",
+		58: "SECOND_FACTOR_LOCKED",
+	}
+	ErrorReason_value = map[string]int32{
+		"USER_UNSPECIFIED":             0,
+		"USER_NOT_FOUND":               1,
+		"USER_ALREADY_EXISTS":          2,
+		"INVALID_CREDENTIALS":          3,
+		"EMAIL_TAKEN":                  4,
+		"USERNAME_TAKEN":               5,
+		"INVALID_PASSWORD":             6,
+		"UNAUTHENTICATED":              7,
+		"TOKEN_INVALID":                8,
+		"TOKEN_EXPIRED":                9,
+		"PERMISSION_DENIED":            10,
+		"REFRESH_TOKEN_INVALID":        11,
+		"SESSION_NOT_FOUND":            12,
+		"CANNOT_FOLLOW_SELF":           13,
+		"ALREADY_FOLLOWING":            14,
+		"NOT_FOLLOWING":                15,
+		"PAGE_TOKEN_INVALID":           16,
+		"PAGE_SIZE_INVALID":            17,
+		"CANNOT_BLOCK_SELF":            18,
+		"ALREADY_BLOCKED":              19,
+		"NOT_BLOCKED":                  20,
+		"CANNOT_MUTE_SELF":             21,
+		"ALREADY_MUTED":                22,
+		"NOT_MUTED":                    23,
+		"USER_BLOCKED":                 24,
+		"FOLLOW_REQUEST_NOT_FOUND":     25,
+		"ALREADY_REQUESTED":            26,
+		"INVALID_PROFILE":              27,
+		"INVALID_UPDATE_MASK":          28,
+		"VERSION_CONFLICT":             29,
+		"INVALID_HANDLE":               30,
+		"HANDLE_RESERVED":              31,
+		"HANDLE_CHANGE_TOO_SOON":       32,
+		"HANDLE_NOT_FOUND":             33,
+		"BATCH_TOO_LARGE":              34,
+		"VERIFICATION_TOKEN_INVALID":   35,
+		"EMAIL_ALREADY_VERIFIED":       36,
+		"VERIFICATION_TOO_SOON":        37,
+		"PASSWORD_RESET_TOKEN_INVALID": 38,
+		"TOTP_NOT_ENABLED":             39,
+		"TOTP_ALREADY_ENABLED":         40,
+		"SECOND_FACTOR_INVALID":        41,
+		"CHALLENGE_INVALID":            42,
+		"SESSION_REVOKED":              43,
+		"OAUTH_CLIENT_NOT_FOUND":       44,
+		"INVALID_OAUTH_CLIENT":         45,
+		"AUTHORIZATION_CODE_INVALID":   46,
+		"IDENTITY_PROVIDER_NOT_FOUND":  47,
+		"FEDERATED_LOGIN_INVALID":      48,
+		"FEDERATED_LOGIN_FAILED":       49,
+		"FEDERATED_EMAIL_UNVERIFIED":   50,
+		"FEDERATED_ACCOUNT_CONFLICT":   51,
+		"LINKED_IDENTITY_NOT_FOUND":    52,
+		"LAST_LOGIN_METHOD":            53,
+		"API_KEY_NOT_FOUND":            54,
+		"INVALID_API_KEY":              55,
+		"API_KEY_LIMIT_REACHED":        56,
+		"INVALID_EMAIL":                57,
+		"SECOND_FACTOR_LOCKED":         58,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_v1_error_reason_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_user_v1_error_reason_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_user_v1_error_reason_proto_rawDescGZIP(), []int{0}
+}
+
+var File_user_v1_error_reason_proto protoreflect.FileDescriptor
+
+var file_user_v1_error_reason_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2a, 0x93, 0x0b, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12,
+	0x17, 0x0a, 0x13, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f,
+	0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x53, 0x10,
+	0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x54, 0x41, 0x4b, 0x45, 0x4e,
+	0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54,
+	0x41, 0x4b, 0x45, 0x4e, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f,
+	0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x07, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x09, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x45, 0x52, 0x4d, 0x49,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x19,
+	0x0a, 0x15, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x0b, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x45, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0c,
+	0x12, 0x16, 0x0a, 0x12, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f,
+	0x57, 0x5f, 0x53, 0x45, 0x4c, 0x46, 0x10, 0x0d, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x4c, 0x52, 0x45,
+	0x41, 0x44, 0x59, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x49, 0x4e, 0x47, 0x10, 0x0e, 0x12,
+	0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x49, 0x4e, 0x47,
+	0x10, 0x0f, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x10, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x41,
+	0x47, 0x45, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10,
+	0x11, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x4c, 0x4f, 0x43,
+	0x4b, 0x5f, 0x53, 0x45, 0x4c, 0x46, 0x10, 0x12, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x4c, 0x52, 0x45,
+	0x41, 0x44, 0x59, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x13, 0x12, 0x0f, 0x0a,
+	0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x14, 0x12, 0x14,
+	0x0a, 0x10, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x4d, 0x55, 0x54, 0x45, 0x5f, 0x53, 0x45,
+	0x4c, 0x46, 0x10, 0x15, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f,
+	0x4d, 0x55, 0x54, 0x45, 0x44, 0x10, 0x16, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x4d,
+	0x55, 0x54, 0x45, 0x44, 0x10, 0x17, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x42,
+	0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x18, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x4f, 0x4c, 0x4c,
+	0x4f, 0x57, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x19, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44,
+	0x59, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x1a, 0x12, 0x13, 0x0a,
+	0x0f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45,
+	0x10, 0x1b, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x53, 0x4b, 0x10, 0x1c, 0x12, 0x14, 0x0a, 0x10, 0x56,
+	0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10,
+	0x1d, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x48, 0x41, 0x4e,
+	0x44, 0x4c, 0x45, 0x10, 0x1e, 0x12, 0x13, 0x0a, 0x0f, 0x48, 0x41, 0x4e, 0x44, 0x4c, 0x45, 0x5f,
+	0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x1f, 0x12, 0x1a, 0x0a, 0x16, 0x48, 0x41,
+	0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f,
+	0x53, 0x4f, 0x4f, 0x4e, 0x10, 0x20, 0x12, 0x14, 0x0a, 0x10, 0x48, 0x41, 0x4e, 0x44, 0x4c, 0x45,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x21, 0x12, 0x13, 0x0a, 0x0f,
+	0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x41, 0x52, 0x47, 0x45, 0x10,
+	0x22, 0x12, 0x1e, 0x0a, 0x1a, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10,
+	0x23, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x24, 0x12, 0x19, 0x0a,
+	0x15, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x4f,
+	0x4f, 0x5f, 0x53, 0x4f, 0x4f, 0x4e, 0x10, 0x25, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x41, 0x53, 0x53,
+	0x57, 0x4f, 0x52, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x26, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x4f,
+	0x54, 0x50, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x27,
+	0x12, 0x18, 0x0a, 0x14, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x28, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x45,
+	0x43, 0x4f, 0x4e, 0x44, 0x5f, 0x46, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x10, 0x29, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e,
+	0x47, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x2a, 0x12, 0x13, 0x0a, 0x0f,
+	0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10,
+	0x2b, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e,
+	0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x2c, 0x12, 0x18, 0x0a,
+	0x14, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x43,
+	0x4c, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x2d, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x55, 0x54, 0x48, 0x4f,
+	0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x2e, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x44, 0x45, 0x4e, 0x54,
+	0x49, 0x54, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x2f, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x45, 0x44, 0x45,
+	0x52, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x10, 0x30, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x45, 0x44, 0x45, 0x52, 0x41, 0x54,
+	0x45, 0x44, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x31, 0x12, 0x1e, 0x0a, 0x1a, 0x46, 0x45, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x45,
+	0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x55, 0x4e, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x32, 0x12, 0x1e, 0x0a, 0x1a, 0x46, 0x45, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x41,
+	0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10,
+	0x33, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x4e, 0x4b, 0x45, 0x44, 0x5f, 0x49, 0x44, 0x45, 0x4e,
+	0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x34,
+	0x12, 0x15, 0x0a, 0x11, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x4d,
+	0x45, 0x54, 0x48, 0x4f, 0x44, 0x10, 0x35, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x50, 0x49, 0x5f, 0x4b,
+	0x45, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x36, 0x12, 0x13,
+	0x0a, 0x0f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45,
+	0x59, 0x10, 0x37, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4c,
+	0x49, 0x4d, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0x38, 0x12, 0x11,
+	0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10,
+	0x39, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x5f, 0x46, 0x41, 0x43, 0x54,
+	0x4f, 0x52, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x3a, 0x42, 0x2c, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x13, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xa2, 0x02, 0x09,
+	0x41, 0x50, 0x49, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_user_v1_error_reason_proto_rawDescOnce sync.Once
+	file_user_v1_error_reason_proto_rawDescData = file_user_v1_error_reason_proto_rawDesc
+)
+
+func file_user_v1_error_reason_proto_rawDescGZIP() []byte {
+	file_user_v1_error_reason_proto_rawDescOnce.Do(func() {
+		file_user_v1_error_reason_proto_rawDescData = protoimpl.X.CompressGZIP(file_user_v1_error_reason_proto_rawDescData)
+	})
+	return file_user_v1_error_reason_proto_rawDescData
+}
+
+var file_user_v1_error_reason_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_user_v1_error_reason_proto_goTypes = []interface{}{
+	(ErrorReason)(0), // 0: user.v1.ErrorReason
+}
+var file_user_v1_error_reason_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_user_v1_error_reason_proto_init() }
+func file_user_v1_error_reason_proto_init() {
+	if File_user_v1_error_reason_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_user_v1_error_reason_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_user_v1_error_reason_proto_goTypes,
+		DependencyIndexes: file_user_v1_error_reason_proto_depIdxs,
+		EnumInfos:         file_user_v1_error_reason_proto_enumTypes,
+	}.Build()
+	File_user_v1_error_reason_proto = out.File
+	file_user_v1_error_reason_proto_rawDesc = nil
+	file_user_v1_error_reason_proto_goTypes = nil
+	file_user_v1_error_reason_proto_depIdxs = nil
+}
@@ -0,0 +1,3166 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/v1/graph.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on FollowInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FollowInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FollowInfoMultiError, or
+// nil if none found.
+func (m *FollowInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetFollowTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FollowInfoValidationError{
+					field:  "FollowTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FollowInfoValidationError{
+					field:  "FollowTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFollowTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FollowInfoValidationError{
+				field:  "FollowTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FollowInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowInfoMultiError is an error wrapping multiple validation errors
+// returned by FollowInfo.ValidateAll() if the designated constraints aren't met.
+type FollowInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowInfoMultiError) AllErrors() []error { return m }
+
+// FollowInfoValidationError is the validation error returned by
+// FollowInfo.Validate if the designated constraints aren't met.
+type FollowInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowInfoValidationError) ErrorName() string { return "FollowInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FollowInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowInfoValidationError{}
+
+// Validate checks the field values on FollowRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FollowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FollowRequestMultiError, or
+// nil if none found.
+func (m *FollowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return FollowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowRequestMultiError is an error wrapping multiple validation errors
+// returned by FollowRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FollowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowRequestMultiError) AllErrors() []error { return m }
+
+// FollowRequestValidationError is the validation error returned by
+// FollowRequest.Validate if the designated constraints aren't met.
+type FollowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowRequestValidationError) ErrorName() string { return "FollowRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FollowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowRequestValidationError{}
+
+// Validate checks the field values on FollowReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FollowReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FollowReplyMultiError, or
+// nil if none found.
+func (m *FollowReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFollow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FollowReplyValidationError{
+					field:  "Follow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FollowReplyValidationError{
+					field:  "Follow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFollow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FollowReplyValidationError{
+				field:  "Follow",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Pending
+
+	if len(errors) > 0 {
+		return FollowReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowReplyMultiError is an error wrapping multiple validation errors
+// returned by FollowReply.ValidateAll() if the designated constraints aren't met.
+type FollowReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowReplyMultiError) AllErrors() []error { return m }
+
+// FollowReplyValidationError is the validation error returned by
+// FollowReply.Validate if the designated constraints aren't met.
+type FollowReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowReplyValidationError) ErrorName() string { return "FollowReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FollowReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowReplyValidationError{}
+
+// Validate checks the field values on UnfollowRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnfollowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnfollowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnfollowRequestMultiError, or nil if none found.
+func (m *UnfollowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnfollowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return UnfollowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnfollowRequestMultiError is an error wrapping multiple validation errors
+// returned by UnfollowRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnfollowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnfollowRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnfollowRequestMultiError) AllErrors() []error { return m }
+
+// UnfollowRequestValidationError is the validation error returned by
+// UnfollowRequest.Validate if the designated constraints aren't met.
+type UnfollowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnfollowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnfollowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnfollowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnfollowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnfollowRequestValidationError) ErrorName() string { return "UnfollowRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnfollowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnfollowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnfollowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnfollowRequestValidationError{}
+
+// Validate checks the field values on UnfollowReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnfollowReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnfollowReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnfollowReplyMultiError, or
+// nil if none found.
+func (m *UnfollowReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnfollowReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnfollowReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnfollowReplyMultiError is an error wrapping multiple validation errors
+// returned by UnfollowReply.ValidateAll() if the designated constraints
+// aren't met.
+type UnfollowReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnfollowReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnfollowReplyMultiError) AllErrors() []error { return m }
+
+// UnfollowReplyValidationError is the validation error returned by
+// UnfollowReply.Validate if the designated constraints aren't met.
+type UnfollowReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnfollowReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnfollowReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnfollowReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnfollowReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnfollowReplyValidationError) ErrorName() string { return "UnfollowReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnfollowReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnfollowReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnfollowReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnfollowReplyValidationError{}
+
+// Validate checks the field values on ListFollowersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFollowersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFollowersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFollowersRequestMultiError, or nil if none found.
+func (m *ListFollowersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFollowersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListFollowersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFollowersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFollowersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFollowersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFollowersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFollowersRequestMultiError) AllErrors() []error { return m }
+
+// ListFollowersRequestValidationError is the validation error returned by
+// ListFollowersRequest.Validate if the designated constraints aren't met.
+type ListFollowersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFollowersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFollowersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFollowersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFollowersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFollowersRequestValidationError) ErrorName() string {
+	return "ListFollowersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFollowersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFollowersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFollowersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFollowersRequestValidationError{}
+
+// Validate checks the field values on ListFollowersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFollowersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFollowersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFollowersReplyMultiError, or nil if none found.
+func (m *ListFollowersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFollowersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFollowers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFollowersReplyValidationError{
+						field:  fmt.Sprintf("Followers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFollowersReplyValidationError{
+						field:  fmt.Sprintf("Followers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFollowersReplyValidationError{
+					field:  fmt.Sprintf("Followers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListFollowersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFollowersReplyMultiError is an error wrapping multiple validation errors
+// returned by ListFollowersReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListFollowersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFollowersReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFollowersReplyMultiError) AllErrors() []error { return m }
+
+// ListFollowersReplyValidationError is the validation error returned by
+// ListFollowersReply.Validate if the designated constraints aren't met.
+type ListFollowersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFollowersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFollowersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFollowersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFollowersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFollowersReplyValidationError) ErrorName() string {
+	return "ListFollowersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFollowersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFollowersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFollowersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFollowersReplyValidationError{}
+
+// Validate checks the field values on ListFollowingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFollowingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFollowingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFollowingRequestMultiError, or nil if none found.
+func (m *ListFollowingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFollowingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListFollowingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFollowingRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFollowingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFollowingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFollowingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFollowingRequestMultiError) AllErrors() []error { return m }
+
+// ListFollowingRequestValidationError is the validation error returned by
+// ListFollowingRequest.Validate if the designated constraints aren't met.
+type ListFollowingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFollowingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFollowingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFollowingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFollowingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFollowingRequestValidationError) ErrorName() string {
+	return "ListFollowingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFollowingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFollowingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFollowingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFollowingRequestValidationError{}
+
+// Validate checks the field values on ListFollowingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFollowingReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFollowingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFollowingReplyMultiError, or nil if none found.
+func (m *ListFollowingReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFollowingReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFollowing() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFollowingReplyValidationError{
+						field:  fmt.Sprintf("Following[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFollowingReplyValidationError{
+						field:  fmt.Sprintf("Following[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFollowingReplyValidationError{
+					field:  fmt.Sprintf("Following[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListFollowingReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFollowingReplyMultiError is an error wrapping multiple validation errors
+// returned by ListFollowingReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListFollowingReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFollowingReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFollowingReplyMultiError) AllErrors() []error { return m }
+
+// ListFollowingReplyValidationError is the validation error returned by
+// ListFollowingReply.Validate if the designated constraints aren't met.
+type ListFollowingReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFollowingReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFollowingReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFollowingReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFollowingReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFollowingReplyValidationError) ErrorName() string {
+	return "ListFollowingReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFollowingReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFollowingReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFollowingReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFollowingReplyValidationError{}
+
+// Validate checks the field values on IsFollowingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IsFollowingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsFollowingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IsFollowingRequestMultiError, or nil if none found.
+func (m *IsFollowingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsFollowingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for TargetId
+
+	if len(errors) > 0 {
+		return IsFollowingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsFollowingRequestMultiError is an error wrapping multiple validation errors
+// returned by IsFollowingRequest.ValidateAll() if the designated constraints
+// aren't met.
+type IsFollowingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsFollowingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsFollowingRequestMultiError) AllErrors() []error { return m }
+
+// IsFollowingRequestValidationError is the validation error returned by
+// IsFollowingRequest.Validate if the designated constraints aren't met.
+type IsFollowingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsFollowingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsFollowingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsFollowingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsFollowingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsFollowingRequestValidationError) ErrorName() string {
+	return "IsFollowingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IsFollowingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsFollowingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsFollowingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsFollowingRequestValidationError{}
+
+// Validate checks the field values on IsFollowingReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IsFollowingReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsFollowingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IsFollowingReplyMultiError, or nil if none found.
+func (m *IsFollowingReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsFollowingReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Following
+
+	if len(errors) > 0 {
+		return IsFollowingReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsFollowingReplyMultiError is an error wrapping multiple validation errors
+// returned by IsFollowingReply.ValidateAll() if the designated constraints
+// aren't met.
+type IsFollowingReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsFollowingReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsFollowingReplyMultiError) AllErrors() []error { return m }
+
+// IsFollowingReplyValidationError is the validation error returned by
+// IsFollowingReply.Validate if the designated constraints aren't met.
+type IsFollowingReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsFollowingReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsFollowingReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsFollowingReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsFollowingReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsFollowingReplyValidationError) ErrorName() string { return "IsFollowingReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IsFollowingReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsFollowingReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsFollowingReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsFollowingReplyValidationError{}
+
+// Validate checks the field values on FollowRequestInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FollowRequestInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowRequestInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowRequestInfoMultiError, or nil if none found.
+func (m *FollowRequestInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowRequestInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequesterId
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FollowRequestInfoValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FollowRequestInfoValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FollowRequestInfoValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpireTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FollowRequestInfoValidationError{
+					field:  "ExpireTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FollowRequestInfoValidationError{
+					field:  "ExpireTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpireTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FollowRequestInfoValidationError{
+				field:  "ExpireTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FollowRequestInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowRequestInfoMultiError is an error wrapping multiple validation errors
+// returned by FollowRequestInfo.ValidateAll() if the designated constraints
+// aren't met.
+type FollowRequestInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowRequestInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowRequestInfoMultiError) AllErrors() []error { return m }
+
+// FollowRequestInfoValidationError is the validation error returned by
+// FollowRequestInfo.Validate if the designated constraints aren't met.
+type FollowRequestInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowRequestInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowRequestInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowRequestInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowRequestInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowRequestInfoValidationError) ErrorName() string {
+	return "FollowRequestInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowRequestInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowRequestInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowRequestInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowRequestInfoValidationError{}
+
+// Validate checks the field values on ListFollowRequestsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFollowRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFollowRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFollowRequestsRequestMultiError, or nil if none found.
+func (m *ListFollowRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFollowRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListFollowRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFollowRequestsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFollowRequestsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListFollowRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFollowRequestsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFollowRequestsRequestMultiError) AllErrors() []error { return m }
+
+// ListFollowRequestsRequestValidationError is the validation error returned by
+// ListFollowRequestsRequest.Validate if the designated constraints aren't met.
+type ListFollowRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFollowRequestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFollowRequestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFollowRequestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFollowRequestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFollowRequestsRequestValidationError) ErrorName() string {
+	return "ListFollowRequestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFollowRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFollowRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFollowRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFollowRequestsRequestValidationError{}
+
+// Validate checks the field values on ListFollowRequestsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFollowRequestsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFollowRequestsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFollowRequestsReplyMultiError, or nil if none found.
+func (m *ListFollowRequestsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFollowRequestsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFollowRequestsReplyValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFollowRequestsReplyValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFollowRequestsReplyValidationError{
+					field:  fmt.Sprintf("Requests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListFollowRequestsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFollowRequestsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListFollowRequestsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListFollowRequestsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFollowRequestsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFollowRequestsReplyMultiError) AllErrors() []error { return m }
+
+// ListFollowRequestsReplyValidationError is the validation error returned by
+// ListFollowRequestsReply.Validate if the designated constraints aren't met.
+type ListFollowRequestsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFollowRequestsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFollowRequestsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFollowRequestsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFollowRequestsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFollowRequestsReplyValidationError) ErrorName() string {
+	return "ListFollowRequestsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFollowRequestsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFollowRequestsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFollowRequestsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFollowRequestsReplyValidationError{}
+
+// Validate checks the field values on ApproveFollowRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveFollowRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveFollowRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveFollowRequestRequestMultiError, or nil if none found.
+func (m *ApproveFollowRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveFollowRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequesterId
+
+	if len(errors) > 0 {
+		return ApproveFollowRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveFollowRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by ApproveFollowRequestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ApproveFollowRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveFollowRequestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveFollowRequestRequestMultiError) AllErrors() []error { return m }
+
+// ApproveFollowRequestRequestValidationError is the validation error returned
+// by ApproveFollowRequestRequest.Validate if the designated constraints
+// aren't met.
+type ApproveFollowRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveFollowRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveFollowRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveFollowRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveFollowRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveFollowRequestRequestValidationError) ErrorName() string {
+	return "ApproveFollowRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveFollowRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveFollowRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveFollowRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveFollowRequestRequestValidationError{}
+
+// Validate checks the field values on ApproveFollowRequestReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveFollowRequestReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveFollowRequestReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveFollowRequestReplyMultiError, or nil if none found.
+func (m *ApproveFollowRequestReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveFollowRequestReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFollow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveFollowRequestReplyValidationError{
+					field:  "Follow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveFollowRequestReplyValidationError{
+					field:  "Follow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFollow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveFollowRequestReplyValidationError{
+				field:  "Follow",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApproveFollowRequestReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveFollowRequestReplyMultiError is an error wrapping multiple validation
+// errors returned by ApproveFollowRequestReply.ValidateAll() if the
+// designated constraints aren't met.
+type ApproveFollowRequestReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveFollowRequestReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveFollowRequestReplyMultiError) AllErrors() []error { return m }
+
+// ApproveFollowRequestReplyValidationError is the validation error returned by
+// ApproveFollowRequestReply.Validate if the designated constraints aren't met.
+type ApproveFollowRequestReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveFollowRequestReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveFollowRequestReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveFollowRequestReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveFollowRequestReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveFollowRequestReplyValidationError) ErrorName() string {
+	return "ApproveFollowRequestReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveFollowRequestReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveFollowRequestReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveFollowRequestReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveFollowRequestReplyValidationError{}
+
+// Validate checks the field values on RejectFollowRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectFollowRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectFollowRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectFollowRequestRequestMultiError, or nil if none found.
+func (m *RejectFollowRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectFollowRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequesterId
+
+	if len(errors) > 0 {
+		return RejectFollowRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectFollowRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by RejectFollowRequestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RejectFollowRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectFollowRequestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectFollowRequestRequestMultiError) AllErrors() []error { return m }
+
+// RejectFollowRequestRequestValidationError is the validation error returned
+// by RejectFollowRequestRequest.Validate if the designated constraints aren't met.
+type RejectFollowRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectFollowRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectFollowRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectFollowRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectFollowRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectFollowRequestRequestValidationError) ErrorName() string {
+	return "RejectFollowRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectFollowRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectFollowRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectFollowRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectFollowRequestRequestValidationError{}
+
+// Validate checks the field values on RejectFollowRequestReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectFollowRequestReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectFollowRequestReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectFollowRequestReplyMultiError, or nil if none found.
+func (m *RejectFollowRequestReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectFollowRequestReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RejectFollowRequestReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectFollowRequestReplyMultiError is an error wrapping multiple validation
+// errors returned by RejectFollowRequestReply.ValidateAll() if the designated
+// constraints aren't met.
+type RejectFollowRequestReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectFollowRequestReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectFollowRequestReplyMultiError) AllErrors() []error { return m }
+
+// RejectFollowRequestReplyValidationError is the validation error returned by
+// RejectFollowRequestReply.Validate if the designated constraints aren't met.
+type RejectFollowRequestReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectFollowRequestReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectFollowRequestReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectFollowRequestReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectFollowRequestReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectFollowRequestReplyValidationError) ErrorName() string {
+	return "RejectFollowRequestReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectFollowRequestReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectFollowRequestReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectFollowRequestReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectFollowRequestReplyValidationError{}
+
+// Validate checks the field values on BlockRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlockRequestMultiError, or
+// nil if none found.
+func (m *BlockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return BlockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockRequestMultiError is an error wrapping multiple validation errors
+// returned by BlockRequest.ValidateAll() if the designated constraints aren't met.
+type BlockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockRequestMultiError) AllErrors() []error { return m }
+
+// BlockRequestValidationError is the validation error returned by
+// BlockRequest.Validate if the designated constraints aren't met.
+type BlockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockRequestValidationError) ErrorName() string { return "BlockRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockRequestValidationError{}
+
+// Validate checks the field values on BlockReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlockReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlockReplyMultiError, or
+// nil if none found.
+func (m *BlockReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return BlockReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockReplyMultiError is an error wrapping multiple validation errors
+// returned by BlockReply.ValidateAll() if the designated constraints aren't met.
+type BlockReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockReplyMultiError) AllErrors() []error { return m }
+
+// BlockReplyValidationError is the validation error returned by
+// BlockReply.Validate if the designated constraints aren't met.
+type BlockReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockReplyValidationError) ErrorName() string { return "BlockReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockReplyValidationError{}
+
+// Validate checks the field values on UnblockRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnblockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnblockRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnblockRequestMultiError,
+// or nil if none found.
+func (m *UnblockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnblockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return UnblockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnblockRequestMultiError is an error wrapping multiple validation errors
+// returned by UnblockRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnblockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnblockRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnblockRequestMultiError) AllErrors() []error { return m }
+
+// UnblockRequestValidationError is the validation error returned by
+// UnblockRequest.Validate if the designated constraints aren't met.
+type UnblockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnblockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnblockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnblockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnblockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnblockRequestValidationError) ErrorName() string { return "UnblockRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnblockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnblockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnblockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnblockRequestValidationError{}
+
+// Validate checks the field values on UnblockReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnblockReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnblockReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnblockReplyMultiError, or
+// nil if none found.
+func (m *UnblockReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnblockReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnblockReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnblockReplyMultiError is an error wrapping multiple validation errors
+// returned by UnblockReply.ValidateAll() if the designated constraints aren't met.
+type UnblockReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnblockReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnblockReplyMultiError) AllErrors() []error { return m }
+
+// UnblockReplyValidationError is the validation error returned by
+// UnblockReply.Validate if the designated constraints aren't met.
+type UnblockReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnblockReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnblockReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnblockReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnblockReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnblockReplyValidationError) ErrorName() string { return "UnblockReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnblockReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnblockReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnblockReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnblockReplyValidationError{}
+
+// Validate checks the field values on MuteRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MuteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MuteRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MuteRequestMultiError, or
+// nil if none found.
+func (m *MuteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MuteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return MuteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MuteRequestMultiError is an error wrapping multiple validation errors
+// returned by MuteRequest.ValidateAll() if the designated constraints aren't met.
+type MuteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MuteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MuteRequestMultiError) AllErrors() []error { return m }
+
+// MuteRequestValidationError is the validation error returned by
+// MuteRequest.Validate if the designated constraints aren't met.
+type MuteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MuteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MuteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MuteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MuteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MuteRequestValidationError) ErrorName() string { return "MuteRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MuteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMuteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MuteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MuteRequestValidationError{}
+
+// Validate checks the field values on MuteReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MuteReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MuteReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MuteReplyMultiError, or nil
+// if none found.
+func (m *MuteReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MuteReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MuteReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MuteReplyMultiError is an error wrapping multiple validation errors returned
+// by MuteReply.ValidateAll() if the designated constraints aren't met.
+type MuteReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MuteReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MuteReplyMultiError) AllErrors() []error { return m }
+
+// MuteReplyValidationError is the validation error returned by
+// MuteReply.Validate if the designated constraints aren't met.
+type MuteReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MuteReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MuteReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MuteReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MuteReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MuteReplyValidationError) ErrorName() string { return "MuteReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MuteReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMuteReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MuteReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MuteReplyValidationError{}
+
+// Validate checks the field values on UnmuteRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnmuteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnmuteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnmuteRequestMultiError, or
+// nil if none found.
+func (m *UnmuteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnmuteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return UnmuteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnmuteRequestMultiError is an error wrapping multiple validation errors
+// returned by UnmuteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnmuteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnmuteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnmuteRequestMultiError) AllErrors() []error { return m }
+
+// UnmuteRequestValidationError is the validation error returned by
+// UnmuteRequest.Validate if the designated constraints aren't met.
+type UnmuteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnmuteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnmuteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnmuteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnmuteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnmuteRequestValidationError) ErrorName() string { return "UnmuteRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnmuteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnmuteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnmuteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnmuteRequestValidationError{}
+
+// Validate checks the field values on UnmuteReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnmuteReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnmuteReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnmuteReplyMultiError, or
+// nil if none found.
+func (m *UnmuteReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnmuteReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnmuteReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnmuteReplyMultiError is an error wrapping multiple validation errors
+// returned by UnmuteReply.ValidateAll() if the designated constraints aren't met.
+type UnmuteReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnmuteReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnmuteReplyMultiError) AllErrors() []error { return m }
+
+// UnmuteReplyValidationError is the validation error returned by
+// UnmuteReply.Validate if the designated constraints aren't met.
+type UnmuteReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnmuteReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnmuteReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnmuteReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnmuteReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnmuteReplyValidationError) ErrorName() string { return "UnmuteReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnmuteReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnmuteReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnmuteReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnmuteReplyValidationError{}
+
+// Validate checks the field values on CheckVisibilityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckVisibilityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckVisibilityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckVisibilityRequestMultiError, or nil if none found.
+func (m *CheckVisibilityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckVisibilityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ViewerId
+
+	// no validation rules for TargetId
+
+	if len(errors) > 0 {
+		return CheckVisibilityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckVisibilityRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckVisibilityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckVisibilityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckVisibilityRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckVisibilityRequestMultiError) AllErrors() []error { return m }
+
+// CheckVisibilityRequestValidationError is the validation error returned by
+// CheckVisibilityRequest.Validate if the designated constraints aren't met.
+type CheckVisibilityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckVisibilityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckVisibilityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckVisibilityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckVisibilityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckVisibilityRequestValidationError) ErrorName() string {
+	return "CheckVisibilityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckVisibilityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckVisibilityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckVisibilityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckVisibilityRequestValidationError{}
+
+// Validate checks the field values on CheckVisibilityReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckVisibilityReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckVisibilityReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckVisibilityReplyMultiError, or nil if none found.
+func (m *CheckVisibilityReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckVisibilityReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Visible
+
+	// no validation rules for Blocked
+
+	// no validation rules for Blocking
+
+	// no validation rules for Muted
+
+	if len(errors) > 0 {
+		return CheckVisibilityReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckVisibilityReplyMultiError is an error wrapping multiple validation
+// errors returned by CheckVisibilityReply.ValidateAll() if the designated
+// constraints aren't met.
+type CheckVisibilityReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckVisibilityReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckVisibilityReplyMultiError) AllErrors() []error { return m }
+
+// CheckVisibilityReplyValidationError is the validation error returned by
+// CheckVisibilityReply.Validate if the designated constraints aren't met.
+type CheckVisibilityReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckVisibilityReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckVisibilityReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckVisibilityReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckVisibilityReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckVisibilityReplyValidationError) ErrorName() string {
+	return "CheckVisibilityReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckVisibilityReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckVisibilityReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckVisibilityReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckVisibilityReplyValidationError{}
@@ -0,0 +1,527 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// protoc-gen-go-http v2.1.3
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+type GraphHTTPServer interface {
+	ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ApproveFollowRequestReply, error)
+	Block(context.Context, *BlockRequest) (*BlockReply, error)
+	CheckVisibility(context.Context, *CheckVisibilityRequest) (*CheckVisibilityReply, error)
+	Follow(context.Context, *FollowRequest) (*FollowReply, error)
+	IsFollowing(context.Context, *IsFollowingRequest) (*IsFollowingReply, error)
+	ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ListFollowRequestsReply, error)
+	ListFollowers(context.Context, *ListFollowersRequest) (*ListFollowersReply, error)
+	ListFollowing(context.Context, *ListFollowingRequest) (*ListFollowingReply, error)
+	Mute(context.Context, *MuteRequest) (*MuteReply, error)
+	RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*RejectFollowRequestReply, error)
+	Unblock(context.Context, *UnblockRequest) (*UnblockReply, error)
+	Unfollow(context.Context, *UnfollowRequest) (*UnfollowReply, error)
+	Unmute(context.Context, *UnmuteRequest) (*UnmuteReply, error)
+}
+
+func RegisterGraphHTTPServer(s *http.Server, srv GraphHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/users/{user_id}/follow", _Graph_Follow0_HTTP_Handler(srv))
+	r.DELETE("/v1/users/{user_id}/follow", _Graph_Unfollow0_HTTP_Handler(srv))
+	r.GET("/v1/users/{user_id}/followers", _Graph_ListFollowers0_HTTP_Handler(srv))
+	r.GET("/v1/users/{user_id}/following", _Graph_ListFollowing0_HTTP_Handler(srv))
+	r.GET("/v1/users/{user_id}/following/{target_id}", _Graph_IsFollowing0_HTTP_Handler(srv))
+	r.GET("/v1/follow-requests", _Graph_ListFollowRequests0_HTTP_Handler(srv))
+	r.POST("/v1/follow-requests/{requester_id}/approve", _Graph_ApproveFollowRequest0_HTTP_Handler(srv))
+	r.POST("/v1/follow-requests/{requester_id}/reject", _Graph_RejectFollowRequest0_HTTP_Handler(srv))
+	r.POST("/v1/users/{user_id}/block", _Graph_Block0_HTTP_Handler(srv))
+	r.DELETE("/v1/users/{user_id}/block", _Graph_Unblock0_HTTP_Handler(srv))
+	r.POST("/v1/users/{user_id}/mute", _Graph_Mute0_HTTP_Handler(srv))
+	r.DELETE("/v1/users/{user_id}/mute", _Graph_Unmute0_HTTP_Handler(srv))
+	r.GET("/v1/users/{viewer_id}/visibility/{target_id}", _Graph_CheckVisibility0_HTTP_Handler(srv))
+}
+
+func _Graph_Follow0_HTTP_Handler(srv GraphHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FollowRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.Graph/Follow")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Follow(ctx, req.(*FollowRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FollowReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Graph_Unfollow0_HTTP_Handler(srv GraphHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnfollowRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.Graph/Unfollow")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Unfollow(ctx, req.(*UnfollowRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnfollowReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Graph_ListFollowers0_HTTP_Handler(srv GraphHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListFollowersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.Graph/ListFollowers")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListFollowers(ctx, req.(*ListFollowersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListFollowersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Graph_ListFollowing0_HTTP_Handler(srv GraphHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListFollowingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.Graph/ListFollowing")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListFollowing(ctx, req.(*ListFollowingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListFollowingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Graph_IsFollowing0_HTTP_Handler(srv GraphHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in IsFollowingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.Graph/IsFollowing")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.IsFollowing(ctx, req.(*IsFollowingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*IsFollowingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Graph_ListFollowRequests0_HTTP_Handler(srv GraphHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListFollowRequestsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.Graph/ListFollowRequests")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListFollowRequests(ctx, req.(*ListFollowRequestsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListFollowRequestsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Graph_ApproveFollowRequest0_HTTP_Handler(srv GraphHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApproveFollowRequestRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.Graph/ApproveFollowRequest")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ApproveFollowRequest(ctx, req.(*ApproveFollowRequestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ApproveFollowRequestReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Graph_RejectFollowRequest0_HTTP_Handler(srv GraphHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RejectFollowRequestRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.Graph/RejectFollowRequest")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RejectFollowRequest(ctx, req.(*RejectFollowRequestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RejectFollowRequestReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Graph_Block0_HTTP_Handler(srv GraphHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BlockRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.Graph/Block")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Block(ctx, req.(*BlockRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BlockReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Graph_Unblock0_HTTP_Handler(srv GraphHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnblockRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.Graph/Unblock")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Unblock(ctx, req.(*UnblockRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnblockReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Graph_Mute0_HTTP_Handler(srv GraphHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MuteRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.Graph/Mute")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Mute(ctx, req.(*MuteRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MuteReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Graph_Unmute0_HTTP_Handler(srv GraphHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnmuteRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.Graph/Unmute")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Unmute(ctx, req.(*UnmuteRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnmuteReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Graph_CheckVisibility0_HTTP_Handler(srv GraphHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CheckVisibilityRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.Graph/CheckVisibility")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CheckVisibility(ctx, req.(*CheckVisibilityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CheckVisibilityReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type GraphHTTPClient interface {
+	ApproveFollowRequest(ctx context.Context, req *ApproveFollowRequestRequest, opts ...http.CallOption) (rsp *ApproveFollowRequestReply, err error)
+	Block(ctx context.Context, req *BlockRequest, opts ...http.CallOption) (rsp *BlockReply, err error)
+	CheckVisibility(ctx context.Context, req *CheckVisibilityRequest, opts ...http.CallOption) (rsp *CheckVisibilityReply, err error)
+	Follow(ctx context.Context, req *FollowRequest, opts ...http.CallOption) (rsp *FollowReply, err error)
+	IsFollowing(ctx context.Context, req *IsFollowingRequest, opts ...http.CallOption) (rsp *IsFollowingReply, err error)
+	ListFollowRequests(ctx context.Context, req *ListFollowRequestsRequest, opts ...http.CallOption) (rsp *ListFollowRequestsReply, err error)
+	ListFollowers(ctx context.Context, req *ListFollowersRequest, opts ...http.CallOption) (rsp *ListFollowersReply, err error)
+	ListFollowing(ctx context.Context, req *ListFollowingRequest, opts ...http.CallOption) (rsp *ListFollowingReply, err error)
+	Mute(ctx context.Context, req *MuteRequest, opts ...http.CallOption) (rsp *MuteReply, err error)
+	RejectFollowRequest(ctx context.Context, req *RejectFollowRequestRequest, opts ...http.CallOption) (rsp *RejectFollowRequestReply, err error)
+	Unblock(ctx context.Context, req *UnblockRequest, opts ...http.CallOption) (rsp *UnblockReply, err error)
+	Unfollow(ctx context.Context, req *UnfollowRequest, opts ...http.CallOption) (rsp *UnfollowReply, err error)
+	Unmute(ctx context.Context, req *UnmuteRequest, opts ...http.CallOption) (rsp *UnmuteReply, err error)
+}
+
+type GraphHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewGraphHTTPClient(client *http.Client) GraphHTTPClient {
+	return &GraphHTTPClientImpl{client}
+}
+
+func (c *GraphHTTPClientImpl) ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...http.CallOption) (*ApproveFollowRequestReply, error) {
+	var out ApproveFollowRequestReply
+	pattern := "/v1/follow-requests/{requester_id}/approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/user.v1.Graph/ApproveFollowRequest"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *GraphHTTPClientImpl) Block(ctx context.Context, in *BlockRequest, opts ...http.CallOption) (*BlockReply, error) {
+	var out BlockReply
+	pattern := "/v1/users/{user_id}/block"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/user.v1.Graph/Block"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *GraphHTTPClientImpl) CheckVisibility(ctx context.Context, in *CheckVisibilityRequest, opts ...http.CallOption) (*CheckVisibilityReply, error) {
+	var out CheckVisibilityReply
+	pattern := "/v1/users/{viewer_id}/visibility/{target_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/user.v1.Graph/CheckVisibility"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *GraphHTTPClientImpl) Follow(ctx context.Context, in *FollowRequest, opts ...http.CallOption) (*FollowReply, error) {
+	var out FollowReply
+	pattern := "/v1/users/{user_id}/follow"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/user.v1.Graph/Follow"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *GraphHTTPClientImpl) IsFollowing(ctx context.Context, in *IsFollowingRequest, opts ...http.CallOption) (*IsFollowingReply, error) {
+	var out IsFollowingReply
+	pattern := "/v1/users/{user_id}/following/{target_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/user.v1.Graph/IsFollowing"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *GraphHTTPClientImpl) ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...http.CallOption) (*ListFollowRequestsReply, error) {
+	var out ListFollowRequestsReply
+	pattern := "/v1/follow-requests"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/user.v1.Graph/ListFollowRequests"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *GraphHTTPClientImpl) ListFollowers(ctx context.Context, in *ListFollowersRequest, opts ...http.CallOption) (*ListFollowersReply, error) {
+	var out ListFollowersReply
+	pattern := "/v1/users/{user_id}/followers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/user.v1.Graph/ListFollowers"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *GraphHTTPClientImpl) ListFollowing(ctx context.Context, in *ListFollowingRequest, opts ...http.CallOption) (*ListFollowingReply, error) {
+	var out ListFollowingReply
+	pattern := "/v1/users/{user_id}/following"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/user.v1.Graph/ListFollowing"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *GraphHTTPClientImpl) Mute(ctx context.Context, in *MuteRequest, opts ...http.CallOption) (*MuteReply, error) {
+	var out MuteReply
+	pattern := "/v1/users/{user_id}/mute"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/user.v1.Graph/Mute"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *GraphHTTPClientImpl) RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...http.CallOption) (*RejectFollowRequestReply, error) {
+	var out RejectFollowRequestReply
+	pattern := "/v1/follow-requests/{requester_id}/reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/user.v1.Graph/RejectFollowRequest"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *GraphHTTPClientImpl) Unblock(ctx context.Context, in *UnblockRequest, opts ...http.CallOption) (*UnblockReply, error) {
+	var out UnblockReply
+	pattern := "/v1/users/{user_id}/block"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/user.v1.Graph/Unblock"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *GraphHTTPClientImpl) Unfollow(ctx context.Context, in *UnfollowRequest, opts ...http.CallOption) (*UnfollowReply, error) {
+	var out UnfollowReply
+	pattern := "/v1/users/{user_id}/follow"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/user.v1.Graph/Unfollow"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *GraphHTTPClientImpl) Unmute(ctx context.Context, in *UnmuteRequest, opts ...http.CallOption) (*UnmuteReply, error) {
+	var out UnmuteReply
+	pattern := "/v1/users/{user_id}/mute"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/user.v1.Graph/Unmute"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
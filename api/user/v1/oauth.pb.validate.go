@@ -0,0 +1,1189 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/v1/oauth.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on OAuthClient with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OAuthClient) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OAuthClient with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OAuthClientMultiError, or
+// nil if none found.
+func (m *OAuthClient) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OAuthClient) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientId
+
+	// no validation rules for Name
+
+	// no validation rules for Public
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OAuthClientValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OAuthClientValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OAuthClientValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OAuthClientMultiError(errors)
+	}
+
+	return nil
+}
+
+// OAuthClientMultiError is an error wrapping multiple validation errors
+// returned by OAuthClient.ValidateAll() if the designated constraints aren't met.
+type OAuthClientMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OAuthClientMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OAuthClientMultiError) AllErrors() []error { return m }
+
+// OAuthClientValidationError is the validation error returned by
+// OAuthClient.Validate if the designated constraints aren't met.
+type OAuthClientValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OAuthClientValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OAuthClientValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OAuthClientValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OAuthClientValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OAuthClientValidationError) ErrorName() string { return "OAuthClientValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OAuthClientValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOAuthClient.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OAuthClientValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OAuthClientValidationError{}
+
+// Validate checks the field values on CreateOAuthClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateOAuthClientRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateOAuthClientRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateOAuthClientRequestMultiError, or nil if none found.
+func (m *CreateOAuthClientRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateOAuthClientRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := CreateOAuthClientRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Public
+
+	if len(m.GetRedirectUris()) > 20 {
+		err := CreateOAuthClientRequestValidationError{
+			field:  "RedirectUris",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRedirectUris() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) > 2048 {
+			err := CreateOAuthClientRequestValidationError{
+				field:  fmt.Sprintf("RedirectUris[%v]", idx),
+				reason: "value length must be at most 2048 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if uri, err := url.Parse(item); err != nil {
+			err = CreateOAuthClientRequestValidationError{
+				field:  fmt.Sprintf("RedirectUris[%v]", idx),
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := CreateOAuthClientRequestValidationError{
+				field:  fmt.Sprintf("RedirectUris[%v]", idx),
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetGrantTypes()) > 3 {
+		err := CreateOAuthClientRequestValidationError{
+			field:  "GrantTypes",
+			reason: "value must contain no more than 3 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetScopes()) > 50 {
+		err := CreateOAuthClientRequestValidationError{
+			field:  "Scopes",
+			reason: "value must contain no more than 50 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 64 {
+			err := CreateOAuthClientRequestValidationError{
+				field:  fmt.Sprintf("Scopes[%v]", idx),
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateOAuthClientRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateOAuthClientRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateOAuthClientRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateOAuthClientRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateOAuthClientRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateOAuthClientRequestMultiError) AllErrors() []error { return m }
+
+// CreateOAuthClientRequestValidationError is the validation error returned by
+// CreateOAuthClientRequest.Validate if the designated constraints aren't met.
+type CreateOAuthClientRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateOAuthClientRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateOAuthClientRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateOAuthClientRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateOAuthClientRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateOAuthClientRequestValidationError) ErrorName() string {
+	return "CreateOAuthClientRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateOAuthClientRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateOAuthClientRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateOAuthClientRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateOAuthClientRequestValidationError{}
+
+// Validate checks the field values on CreateOAuthClientReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateOAuthClientReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateOAuthClientReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateOAuthClientReplyMultiError, or nil if none found.
+func (m *CreateOAuthClientReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateOAuthClientReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClient()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateOAuthClientReplyValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateOAuthClientReplyValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClient()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateOAuthClientReplyValidationError{
+				field:  "Client",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ClientSecret
+
+	if len(errors) > 0 {
+		return CreateOAuthClientReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateOAuthClientReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateOAuthClientReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateOAuthClientReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateOAuthClientReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateOAuthClientReplyMultiError) AllErrors() []error { return m }
+
+// CreateOAuthClientReplyValidationError is the validation error returned by
+// CreateOAuthClientReply.Validate if the designated constraints aren't met.
+type CreateOAuthClientReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateOAuthClientReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateOAuthClientReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateOAuthClientReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateOAuthClientReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateOAuthClientReplyValidationError) ErrorName() string {
+	return "CreateOAuthClientReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateOAuthClientReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateOAuthClientReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateOAuthClientReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateOAuthClientReplyValidationError{}
+
+// Validate checks the field values on GetOAuthClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOAuthClientRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOAuthClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOAuthClientRequestMultiError, or nil if none found.
+func (m *GetOAuthClientRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOAuthClientRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientId
+
+	if len(errors) > 0 {
+		return GetOAuthClientRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOAuthClientRequestMultiError is an error wrapping multiple validation
+// errors returned by GetOAuthClientRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetOAuthClientRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOAuthClientRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOAuthClientRequestMultiError) AllErrors() []error { return m }
+
+// GetOAuthClientRequestValidationError is the validation error returned by
+// GetOAuthClientRequest.Validate if the designated constraints aren't met.
+type GetOAuthClientRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOAuthClientRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOAuthClientRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOAuthClientRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOAuthClientRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOAuthClientRequestValidationError) ErrorName() string {
+	return "GetOAuthClientRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOAuthClientRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOAuthClientRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOAuthClientRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOAuthClientRequestValidationError{}
+
+// Validate checks the field values on GetOAuthClientReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOAuthClientReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOAuthClientReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOAuthClientReplyMultiError, or nil if none found.
+func (m *GetOAuthClientReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOAuthClientReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClient()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetOAuthClientReplyValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetOAuthClientReplyValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClient()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetOAuthClientReplyValidationError{
+				field:  "Client",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetOAuthClientReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOAuthClientReplyMultiError is an error wrapping multiple validation
+// errors returned by GetOAuthClientReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetOAuthClientReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOAuthClientReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOAuthClientReplyMultiError) AllErrors() []error { return m }
+
+// GetOAuthClientReplyValidationError is the validation error returned by
+// GetOAuthClientReply.Validate if the designated constraints aren't met.
+type GetOAuthClientReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOAuthClientReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOAuthClientReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOAuthClientReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOAuthClientReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOAuthClientReplyValidationError) ErrorName() string {
+	return "GetOAuthClientReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOAuthClientReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOAuthClientReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOAuthClientReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOAuthClientReplyValidationError{}
+
+// Validate checks the field values on ListOAuthClientsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOAuthClientsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOAuthClientsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOAuthClientsRequestMultiError, or nil if none found.
+func (m *ListOAuthClientsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOAuthClientsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListOAuthClientsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOAuthClientsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListOAuthClientsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListOAuthClientsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOAuthClientsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOAuthClientsRequestMultiError) AllErrors() []error { return m }
+
+// ListOAuthClientsRequestValidationError is the validation error returned by
+// ListOAuthClientsRequest.Validate if the designated constraints aren't met.
+type ListOAuthClientsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOAuthClientsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOAuthClientsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOAuthClientsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOAuthClientsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOAuthClientsRequestValidationError) ErrorName() string {
+	return "ListOAuthClientsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOAuthClientsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOAuthClientsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOAuthClientsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOAuthClientsRequestValidationError{}
+
+// Validate checks the field values on ListOAuthClientsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOAuthClientsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOAuthClientsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOAuthClientsReplyMultiError, or nil if none found.
+func (m *ListOAuthClientsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOAuthClientsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetClients() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOAuthClientsReplyValidationError{
+						field:  fmt.Sprintf("Clients[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOAuthClientsReplyValidationError{
+						field:  fmt.Sprintf("Clients[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOAuthClientsReplyValidationError{
+					field:  fmt.Sprintf("Clients[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListOAuthClientsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOAuthClientsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListOAuthClientsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListOAuthClientsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOAuthClientsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOAuthClientsReplyMultiError) AllErrors() []error { return m }
+
+// ListOAuthClientsReplyValidationError is the validation error returned by
+// ListOAuthClientsReply.Validate if the designated constraints aren't met.
+type ListOAuthClientsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOAuthClientsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOAuthClientsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOAuthClientsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOAuthClientsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOAuthClientsReplyValidationError) ErrorName() string {
+	return "ListOAuthClientsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOAuthClientsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOAuthClientsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOAuthClientsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOAuthClientsReplyValidationError{}
+
+// Validate checks the field values on DeleteOAuthClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteOAuthClientRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteOAuthClientRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteOAuthClientRequestMultiError, or nil if none found.
+func (m *DeleteOAuthClientRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteOAuthClientRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientId
+
+	if len(errors) > 0 {
+		return DeleteOAuthClientRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteOAuthClientRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteOAuthClientRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteOAuthClientRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteOAuthClientRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteOAuthClientRequestMultiError) AllErrors() []error { return m }
+
+// DeleteOAuthClientRequestValidationError is the validation error returned by
+// DeleteOAuthClientRequest.Validate if the designated constraints aren't met.
+type DeleteOAuthClientRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteOAuthClientRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteOAuthClientRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteOAuthClientRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteOAuthClientRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteOAuthClientRequestValidationError) ErrorName() string {
+	return "DeleteOAuthClientRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteOAuthClientRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteOAuthClientRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteOAuthClientRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteOAuthClientRequestValidationError{}
+
+// Validate checks the field values on DeleteOAuthClientReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteOAuthClientReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteOAuthClientReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteOAuthClientReplyMultiError, or nil if none found.
+func (m *DeleteOAuthClientReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteOAuthClientReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteOAuthClientReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteOAuthClientReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteOAuthClientReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteOAuthClientReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteOAuthClientReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteOAuthClientReplyMultiError) AllErrors() []error { return m }
+
+// DeleteOAuthClientReplyValidationError is the validation error returned by
+// DeleteOAuthClientReply.Validate if the designated constraints aren't met.
+type DeleteOAuthClientReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteOAuthClientReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteOAuthClientReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteOAuthClientReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteOAuthClientReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteOAuthClientReplyValidationError) ErrorName() string {
+	return "DeleteOAuthClientReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteOAuthClientReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteOAuthClientReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteOAuthClientReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteOAuthClientReplyValidationError{}
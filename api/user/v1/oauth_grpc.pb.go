@@ -0,0 +1,223 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.3
+// source: user/v1/oauth.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// OAuthAdminClient is the client API for OAuthAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OAuthAdminClient interface {
+	// Registers a client. The reply carries its secret, which is not stored
+	// and cannot be read again
+	CreateOAuthClient(ctx context.Context, in *CreateOAuthClientRequest, opts ...grpc.CallOption) (*CreateOAuthClientReply, error)
+	// Gets a client
+	GetOAuthClient(ctx context.Context, in *GetOAuthClientRequest, opts ...grpc.CallOption) (*GetOAuthClientReply, error)
+	// Lists the clients, the oldest first
+	ListOAuthClients(ctx context.Context, in *ListOAuthClientsRequest, opts ...grpc.CallOption) (*ListOAuthClientsReply, error)
+	// Deletes a client and ends the sessions it holds
+	DeleteOAuthClient(ctx context.Context, in *DeleteOAuthClientRequest, opts ...grpc.CallOption) (*DeleteOAuthClientReply, error)
+}
+
+type oAuthAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOAuthAdminClient(cc grpc.ClientConnInterface) OAuthAdminClient {
+	return &oAuthAdminClient{cc}
+}
+
+func (c *oAuthAdminClient) CreateOAuthClient(ctx context.Context, in *CreateOAuthClientRequest, opts ...grpc.CallOption) (*CreateOAuthClientReply, error) {
+	out := new(CreateOAuthClientReply)
+	err := c.cc.Invoke(ctx, "/user.v1.OAuthAdmin/CreateOAuthClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthAdminClient) GetOAuthClient(ctx context.Context, in *GetOAuthClientRequest, opts ...grpc.CallOption) (*GetOAuthClientReply, error) {
+	out := new(GetOAuthClientReply)
+	err := c.cc.Invoke(ctx, "/user.v1.OAuthAdmin/GetOAuthClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthAdminClient) ListOAuthClients(ctx context.Context, in *ListOAuthClientsRequest, opts ...grpc.CallOption) (*ListOAuthClientsReply, error) {
+	out := new(ListOAuthClientsReply)
+	err := c.cc.Invoke(ctx, "/user.v1.OAuthAdmin/ListOAuthClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthAdminClient) DeleteOAuthClient(ctx context.Context, in *DeleteOAuthClientRequest, opts ...grpc.CallOption) (*DeleteOAuthClientReply, error) {
+	out := new(DeleteOAuthClientReply)
+	err := c.cc.Invoke(ctx, "/user.v1.OAuthAdmin/DeleteOAuthClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OAuthAdminServer is the server API for OAuthAdmin service.
+// All implementations must embed UnimplementedOAuthAdminServer
+// for forward compatibility
+type OAuthAdminServer interface {
+	// Registers a client. The reply carries its secret, which is not stored
+	// and cannot be read again
+	CreateOAuthClient(context.Context, *CreateOAuthClientRequest) (*CreateOAuthClientReply, error)
+	// Gets a client
+	GetOAuthClient(context.Context, *GetOAuthClientRequest) (*GetOAuthClientReply, error)
+	// Lists the clients, the oldest first
+	ListOAuthClients(context.Context, *ListOAuthClientsRequest) (*ListOAuthClientsReply, error)
+	// Deletes a client and ends the sessions it holds
+	DeleteOAuthClient(context.Context, *DeleteOAuthClientRequest) (*DeleteOAuthClientReply, error)
+	mustEmbedUnimplementedOAuthAdminServer()
+}
+
+// UnimplementedOAuthAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedOAuthAdminServer struct {
+}
+
+func (UnimplementedOAuthAdminServer) CreateOAuthClient(context.Context, *CreateOAuthClientRequest) (*CreateOAuthClientReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOAuthClient not implemented")
+}
+func (UnimplementedOAuthAdminServer) GetOAuthClient(context.Context, *GetOAuthClientRequest) (*GetOAuthClientReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOAuthClient not implemented")
+}
+func (UnimplementedOAuthAdminServer) ListOAuthClients(context.Context, *ListOAuthClientsRequest) (*ListOAuthClientsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOAuthClients not implemented")
+}
+func (UnimplementedOAuthAdminServer) DeleteOAuthClient(context.Context, *DeleteOAuthClientRequest) (*DeleteOAuthClientReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOAuthClient not implemented")
+}
+func (UnimplementedOAuthAdminServer) mustEmbedUnimplementedOAuthAdminServer() {}
+
+// UnsafeOAuthAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OAuthAdminServer will
+// result in compilation errors.
+type UnsafeOAuthAdminServer interface {
+	mustEmbedUnimplementedOAuthAdminServer()
+}
+
+func RegisterOAuthAdminServer(s grpc.ServiceRegistrar, srv OAuthAdminServer) {
+	s.RegisterService(&OAuthAdmin_ServiceDesc, srv)
+}
+
+func _OAuthAdmin_CreateOAuthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOAuthClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthAdminServer).CreateOAuthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.OAuthAdmin/CreateOAuthClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthAdminServer).CreateOAuthClient(ctx, req.(*CreateOAuthClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthAdmin_GetOAuthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOAuthClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthAdminServer).GetOAuthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.OAuthAdmin/GetOAuthClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthAdminServer).GetOAuthClient(ctx, req.(*GetOAuthClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthAdmin_ListOAuthClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOAuthClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthAdminServer).ListOAuthClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.OAuthAdmin/ListOAuthClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthAdminServer).ListOAuthClients(ctx, req.(*ListOAuthClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthAdmin_DeleteOAuthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOAuthClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthAdminServer).DeleteOAuthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.OAuthAdmin/DeleteOAuthClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthAdminServer).DeleteOAuthClient(ctx, req.(*DeleteOAuthClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OAuthAdmin_ServiceDesc is the grpc.ServiceDesc for OAuthAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OAuthAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.v1.OAuthAdmin",
+	HandlerType: (*OAuthAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOAuthClient",
+			Handler:    _OAuthAdmin_CreateOAuthClient_Handler,
+		},
+		{
+			MethodName: "GetOAuthClient",
+			Handler:    _OAuthAdmin_GetOAuthClient_Handler,
+		},
+		{
+			MethodName: "ListOAuthClients",
+			Handler:    _OAuthAdmin_ListOAuthClients_Handler,
+		},
+		{
+			MethodName: "DeleteOAuthClient",
+			Handler:    _OAuthAdmin_DeleteOAuthClient_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user/v1/oauth.proto",
+}
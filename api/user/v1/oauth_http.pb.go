@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// protoc-gen-go-http v2.1.3
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+type OAuthAdminHTTPServer interface {
+	CreateOAuthClient(context.Context, *CreateOAuthClientRequest) (*CreateOAuthClientReply, error)
+	DeleteOAuthClient(context.Context, *DeleteOAuthClientRequest) (*DeleteOAuthClientReply, error)
+	GetOAuthClient(context.Context, *GetOAuthClientRequest) (*GetOAuthClientReply, error)
+	ListOAuthClients(context.Context, *ListOAuthClientsRequest) (*ListOAuthClientsReply, error)
+}
+
+func RegisterOAuthAdminHTTPServer(s *http.Server, srv OAuthAdminHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/admin/oauth-clients", _OAuthAdmin_CreateOAuthClient0_HTTP_Handler(srv))
+	r.GET("/v1/admin/oauth-clients/{client_id}", _OAuthAdmin_GetOAuthClient0_HTTP_Handler(srv))
+	r.GET("/v1/admin/oauth-clients", _OAuthAdmin_ListOAuthClients0_HTTP_Handler(srv))
+	r.DELETE("/v1/admin/oauth-clients/{client_id}", _OAuthAdmin_DeleteOAuthClient0_HTTP_Handler(srv))
+}
+
+func _OAuthAdmin_CreateOAuthClient0_HTTP_Handler(srv OAuthAdminHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateOAuthClientRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.OAuthAdmin/CreateOAuthClient")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateOAuthClient(ctx, req.(*CreateOAuthClientRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateOAuthClientReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OAuthAdmin_GetOAuthClient0_HTTP_Handler(srv OAuthAdminHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetOAuthClientRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.OAuthAdmin/GetOAuthClient")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetOAuthClient(ctx, req.(*GetOAuthClientRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetOAuthClientReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OAuthAdmin_ListOAuthClients0_HTTP_Handler(srv OAuthAdminHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListOAuthClientsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.OAuthAdmin/ListOAuthClients")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListOAuthClients(ctx, req.(*ListOAuthClientsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListOAuthClientsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OAuthAdmin_DeleteOAuthClient0_HTTP_Handler(srv OAuthAdminHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteOAuthClientRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/user.v1.OAuthAdmin/DeleteOAuthClient")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteOAuthClient(ctx, req.(*DeleteOAuthClientRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteOAuthClientReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type OAuthAdminHTTPClient interface {
+	CreateOAuthClient(ctx context.Context, req *CreateOAuthClientRequest, opts ...http.CallOption) (rsp *CreateOAuthClientReply, err error)
+	DeleteOAuthClient(ctx context.Context, req *DeleteOAuthClientRequest, opts ...http.CallOption) (rsp *DeleteOAuthClientReply, err error)
+	GetOAuthClient(ctx context.Context, req *GetOAuthClientRequest, opts ...http.CallOption) (rsp *GetOAuthClientReply, err error)
+	ListOAuthClients(ctx context.Context, req *ListOAuthClientsRequest, opts ...http.CallOption) (rsp *ListOAuthClientsReply, err error)
+}
+
+type OAuthAdminHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewOAuthAdminHTTPClient(client *http.Client) OAuthAdminHTTPClient {
+	return &OAuthAdminHTTPClientImpl{client}
+}
+
+func (c *OAuthAdminHTTPClientImpl) CreateOAuthClient(ctx context.Context, in *CreateOAuthClientRequest, opts ...http.CallOption) (*CreateOAuthClientReply, error) {
+	var out CreateOAuthClientReply
+	pattern := "/v1/admin/oauth-clients"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/user.v1.OAuthAdmin/CreateOAuthClient"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *OAuthAdminHTTPClientImpl) DeleteOAuthClient(ctx context.Context, in *DeleteOAuthClientRequest, opts ...http.CallOption) (*DeleteOAuthClientReply, error) {
+	var out DeleteOAuthClientReply
+	pattern := "/v1/admin/oauth-clients/{client_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/user.v1.OAuthAdmin/DeleteOAuthClient"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *OAuthAdminHTTPClientImpl) GetOAuthClient(ctx context.Context, in *GetOAuthClientRequest, opts ...http.CallOption) (*GetOAuthClientReply, error) {
+	var out GetOAuthClientReply
+	pattern := "/v1/admin/oauth-clients/{client_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/user.v1.OAuthAdmin/GetOAuthClient"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *OAuthAdminHTTPClientImpl) ListOAuthClients(ctx context.Context, in *ListOAuthClientsRequest, opts ...http.CallOption) (*ListOAuthClientsReply, error) {
+	var out ListOAuthClientsReply
+	pattern := "/v1/admin/oauth-clients"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/user.v1.OAuthAdmin/ListOAuthClients"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
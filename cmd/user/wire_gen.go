@@ -7,29 +7,91 @@
 package main
 
 import (
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/log"
 	"user/internal/biz"
 	"user/internal/conf"
 	"user/internal/data"
 	"user/internal/server"
 	"user/internal/service"
-	"github.com/go-kratos/kratos/v2"
-	"github.com/go-kratos/kratos/v2/log"
 )
 
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, pagination *conf.Pagination, handles *conf.Handles, mail *conf.Mail, oAuth *conf.OAuth, federation *conf.Federation, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
 	}
-	greeterRepo := data.NewGreeterRepo(dataData, logger)
-	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
-	greeterService := service.NewGreeterService(greeterUsecase)
-	grpcServer := server.NewGRPCServer(confServer, greeterService, logger)
-	httpServer := server.NewHTTPServer(confServer, greeterService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	userRepo := data.NewUserRepo(dataData, logger)
+	blockRepo := data.NewBlockRepo(dataData, logger)
+	graphRepo := data.NewGraphRepo(dataData, logger)
+	followRequestRepo := data.NewFollowRequestRepo(dataData, logger)
+	muteRepo := data.NewMuteRepo(dataData, logger)
+	transaction := data.NewTransaction(dataData)
+	paginator, err := biz.NewPaginator(pagination, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	graphUsecase := biz.NewGraphUsecase(graphRepo, followRequestRepo, blockRepo, muteRepo, userRepo, transaction, paginator, logger)
+	handleChangeRepo := data.NewHandleChangeRepo(dataData, logger)
+	bizHandles := biz.NewHandles(handles, handleChangeRepo)
+	emailVerificationRepo := data.NewEmailVerificationRepo(dataData, logger)
+	mailer, err := data.NewMailer(mail, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	verificationUsecase := biz.NewVerificationUsecase(auth, emailVerificationRepo, userRepo, mailer, transaction, logger)
+	userUsecase := biz.NewUserUsecase(userRepo, blockRepo, graphUsecase, bizHandles, verificationUsecase, transaction, paginator, logger)
+	userService := service.NewUserService(userUsecase)
+	credentialRepo := data.NewCredentialRepo(dataData, logger)
+	refreshTokenRepo := data.NewRefreshTokenRepo(dataData, logger)
+	totpRepo := data.NewTOTPRepo(dataData, logger)
+	loginChallengeRepo := data.NewLoginChallengeRepo(dataData, logger)
+	twoFactor := biz.NewTwoFactor(auth, totpRepo, loginChallengeRepo)
+	sessionRepo := data.NewSessionRepo(dataData, logger)
+	sessionCache := data.NewSessionCache(dataData)
+	tokenManager, err := biz.NewTokenManager(auth)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	sessions := biz.NewSessions(sessionRepo, refreshTokenRepo, sessionCache, tokenManager, logger)
+	apiKeyRepo := data.NewAPIKeyRepo(dataData, logger)
+	apiKeys := biz.NewAPIKeys(apiKeyRepo, logger)
+	passwordHasher := biz.NewPasswordHasher(auth)
+	authUsecase, err := biz.NewAuthUsecase(auth, userRepo, credentialRepo, refreshTokenRepo, bizHandles, verificationUsecase, twoFactor, sessions, apiKeys, transaction, passwordHasher, tokenManager, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	passwordResetRepo := data.NewPasswordResetRepo(dataData, logger)
+	passwordResetUsecase := biz.NewPasswordResetUsecase(auth, passwordResetRepo, userRepo, credentialRepo, sessions, passwordHasher, mailer, transaction, logger)
+	identityProviders, err := data.NewIdentityProviders(federation, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	linkedIdentityRepo := data.NewLinkedIdentityRepo(dataData, logger)
+	federatedLoginRepo := data.NewFederatedLoginRepo(dataData, logger)
+	federationUsecase := biz.NewFederationUsecase(federation, identityProviders, linkedIdentityRepo, federatedLoginRepo, userRepo, credentialRepo, bizHandles, authUsecase, transaction, logger)
+	authService := service.NewAuthService(authUsecase, verificationUsecase, passwordResetUsecase, federationUsecase)
+	graphService := service.NewGraphService(graphUsecase)
+	oAuthClientRepo := data.NewOAuthClientRepo(dataData, logger)
+	authorizationCodeRepo := data.NewAuthorizationCodeRepo(dataData, logger)
+	oAuthUsecase, err := biz.NewOAuthUsecase(oAuth, oAuthClientRepo, authorizationCodeRepo, userRepo, authUsecase, sessions, transaction, tokenManager, paginator, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	oAuthService := service.NewOAuthService(oAuthUsecase)
+	grpcServer := server.NewGRPCServer(confServer, userService, authService, graphService, oAuthService, tokenManager, sessions, apiKeys, logger)
+	httpServer := server.NewHTTPServer(confServer, userService, authService, graphService, oAuthService, tokenManager, sessions, apiKeys, logger)
+	jobServer := server.NewJobServer(confServer, graphUsecase, passwordResetUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, jobServer)
 	return app, func() {
 		cleanup()
 	}, nil
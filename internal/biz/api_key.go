@@ -0,0 +1,263 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	v1 "user/api/user/v1"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Scopes of API keys, naming what they may read or change.
+const (
+	ScopeUsersRead  = "users:read"
+	ScopeUsersWrite = "users:write"
+	ScopeGraphRead  = "graph:read"
+	ScopeGraphWrite = "graph:write"
+)
+
+// APIKeyScopes are the scopes API keys may be granted.
+var APIKeyScopes = []string{ScopeUsersRead, ScopeUsersWrite, ScopeGraphRead, ScopeGraphWrite}
+
+const (
+	// apiKeyTag starts every API key, to tell them from access tokens.
+	apiKeyTag = "uk_"
+	// apiKeyPrefixBytes and apiKeySecretBytes are the entropy of the
+	// lookup prefix and of the secret of a key, which is
+	// "uk_<hex prefix>_<secret>".
+	apiKeyPrefixBytes = 6
+	apiKeySecretBytes = 32
+
+	maxAPIKeyNameLen  = 100
+	maxAPIKeysPerUser = 50
+
+	// apiKeyTouchInterval is how stale the last used time of a key gets
+	// before a request records it again.
+	apiKeyTouchInterval = time.Minute
+)
+
+var (
+	// ErrAPIKeyNotFound is API key not found.
+	ErrAPIKeyNotFound = errors.NotFound(v1.ErrorReason_API_KEY_NOT_FOUND.String(), "api key not found")
+	// ErrAPIKeyLimitReached is a user creating more keys than they may have.
+	ErrAPIKeyLimitReached = errors.BadRequest(v1.ErrorReason_API_KEY_LIMIT_REACHED.String(),
+		fmt.Sprintf("a user has at most %d api keys", maxAPIKeysPerUser))
+)
+
+// invalidAPIKey is the error of an API key breaking a rule.
+func invalidAPIKey(format string, args ...interface{}) error {
+	return errors.BadRequest(v1.ErrorReason_INVALID_API_KEY.String(), fmt.Sprintf(format, args...))
+}
+
+// APIKey is a long-lived credential of a user, for scripts and services,
+// limited to its scopes. Only the hash of the key is stored, and its prefix
+// to look it up by.
+type APIKey struct {
+	ID     int64
+	UserID int64
+	Name   string
+	// Prefix is the start of the key, unique among keys.
+	Prefix  string
+	KeyHash string
+	Scopes  []string
+	// ExpiresAt is nil for keys that do not expire.
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// APIKeyRepo is an APIKey repo.
+type APIKeyRepo interface {
+	Save(context.Context, *APIKey) (*APIKey, error)
+	// FindByPrefix returns the key of a prefix, or ErrAPIKeyNotFound.
+	FindByPrefix(ctx context.Context, prefix string) (*APIKey, error)
+	// List returns the keys of a user ordered by id.
+	List(ctx context.Context, userID int64) ([]*APIKey, error)
+	// Touch sets the last used time of a key.
+	Touch(ctx context.Context, id int64, at time.Time) error
+	// Delete removes a key of a user, or returns ErrAPIKeyNotFound.
+	Delete(ctx context.Context, userID, id int64) error
+}
+
+// APIKeys authenticates the requests made with API keys.
+type APIKeys struct {
+	repo APIKeyRepo
+	log  *log.Helper
+}
+
+// NewAPIKeys new an APIKeys.
+func NewAPIKeys(repo APIKeyRepo, logger log.Logger) *APIKeys {
+	return &APIKeys{repo: repo, log: log.NewHelper(logger)}
+}
+
+// IsAPIKey reports whether a bearer token is an API key rather than an
+// access token.
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, apiKeyTag)
+}
+
+// Check returns the principal of an API key, and records its use.
+func (k *APIKeys) Check(ctx context.Context, key string) (*Principal, error) {
+	prefix, ok := apiKeyPrefixOf(key)
+	if !ok {
+		return nil, ErrTokenInvalid
+	}
+	ak, err := k.repo.FindByPrefix(ctx, prefix)
+	if errors.Is(err, ErrAPIKeyNotFound) {
+		return nil, ErrTokenInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashToken(key)), []byte(ak.KeyHash)) != 1 {
+		return nil, ErrTokenInvalid
+	}
+	t := time.Now()
+	if ak.ExpiresAt != nil && !ak.ExpiresAt.After(t) {
+		return nil, ErrTokenExpired
+	}
+	// Recording every use would write on every request.
+	if ak.LastUsedAt == nil || t.Sub(*ak.LastUsedAt) >= apiKeyTouchInterval {
+		if err := k.repo.Touch(ctx, ak.ID, t); err != nil {
+			k.log.WithContext(ctx).Errorf("touch api key %d: %v", ak.ID, err)
+		}
+	}
+	return &Principal{UserID: ak.UserID, Scope: strings.Join(ak.Scopes, " "), APIKeyID: ak.ID}, nil
+}
+
+// apiKeyPrefixOf returns the prefix of a well-formed key.
+func apiKeyPrefixOf(key string) (string, bool) {
+	n := len(apiKeyTag) + hex.EncodedLen(apiKeyPrefixBytes)
+	if len(key) <= n+1 || key[n] != '_' || !IsAPIKey(key) {
+		return "", false
+	}
+	return key[:n], true
+}
+
+// newAPIKey returns a random key and its prefix.
+func newAPIKey() (key, prefix string, err error) {
+	b := make([]byte, apiKeyPrefixBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	secret, err := randomToken(apiKeySecretBytes)
+	if err != nil {
+		return "", "", err
+	}
+	prefix = apiKeyTag + hex.EncodeToString(b)
+	return prefix + "_" + secret, prefix, nil
+}
+
+// normalize checks the name, scopes and expiry of a new key.
+func (k *APIKey) normalize() error {
+	k.Name = strings.TrimSpace(k.Name)
+	k.Scopes = dedupe(k.Scopes)
+	if n := utf8.RuneCountInString(k.Name); n == 0 || n > maxAPIKeyNameLen {
+		return invalidAPIKey("name must be between 1 and %d characters", maxAPIKeyNameLen)
+	}
+	if len(k.Scopes) == 0 {
+		return invalidAPIKey("at least one scope is required")
+	}
+	for _, s := range k.Scopes {
+		if !contains(APIKeyScopes, s) {
+			return invalidAPIKey("unknown scope %q", s)
+		}
+	}
+	if k.ExpiresAt != nil && !k.ExpiresAt.After(time.Now()) {
+		return invalidAPIKey("expire time must be in the future")
+	}
+	return nil
+}
+
+// authorizeCredentials returns the authenticated user, if they logged in
+// to the service itself: API keys and the tokens of OAuth clients do not
+// manage credentials.
+func authorizeCredentials(ctx context.Context) (int64, error) {
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if p.Delegated() {
+		return 0, ErrPermissionDenied
+	}
+	return p.UserID, nil
+}
+
+// authorizeAccount checks that the authenticated user of ctx is userID, as
+// authorizeCredentials allows: changing the email or deleting the account
+// would hand it over to whoever holds the key or token.
+func authorizeAccount(ctx context.Context, userID int64) error {
+	id, err := authorizeCredentials(ctx)
+	if err != nil {
+		return err
+	}
+	if id != userID {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// CreateAPIKey creates a key of the authenticated user, and returns it with
+// the key, which is not stored.
+func (uc *AuthUsecase) CreateAPIKey(ctx context.Context, k *APIKey) (*APIKey, string, error) {
+	userID, err := authorizeCredentials(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := k.normalize(); err != nil {
+		return nil, "", err
+	}
+	key, prefix, err := newAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+	k.UserID, k.Prefix, k.KeyHash = userID, prefix, hashToken(key)
+	var nk *APIKey
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		ks, err := uc.apiKeys.repo.List(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if len(ks) >= maxAPIKeysPerUser {
+			return ErrAPIKeyLimitReached
+		}
+		nk, err = uc.apiKeys.repo.Save(ctx, k)
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	uc.log.WithContext(ctx).Infof("CreateAPIKey: %d %s %v", userID, nk.Prefix, nk.Scopes)
+	return nk, key, nil
+}
+
+// ListAPIKeys returns the keys of the authenticated user, expired ones
+// included.
+func (uc *AuthUsecase) ListAPIKeys(ctx context.Context) ([]*APIKey, error) {
+	userID, err := authorizeCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return uc.apiKeys.repo.List(ctx, userID)
+}
+
+// RevokeAPIKey deletes a key of the authenticated user, which is rejected
+// from then on.
+func (uc *AuthUsecase) RevokeAPIKey(ctx context.Context, id int64) error {
+	userID, err := authorizeCredentials(ctx)
+	if err != nil {
+		return err
+	}
+	if err := uc.apiKeys.repo.Delete(ctx, userID, id); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("RevokeAPIKey: %d %d", userID, id)
+	return nil
+}
@@ -0,0 +1,47 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestAuthorizeAccount(t *testing.T) {
+	tests := []struct {
+		name string
+		p    *Principal
+		want error
+	}{
+		{"own session", &Principal{UserID: 1, SessionID: "s"}, nil},
+		{"other user", &Principal{UserID: 2, SessionID: "s"}, ErrPermissionDenied},
+		{"api key", &Principal{UserID: 1, APIKeyID: 7, Scope: ScopeUsersWrite}, ErrPermissionDenied},
+		{"oauth client", &Principal{UserID: 1, SessionID: "s", ClientID: "c"}, ErrPermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorizeAccount(NewPrincipalContext(context.Background(), tt.p), 1)
+			if tt.want == nil && err != nil || tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("authorizeAccount() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+	if err := authorizeAccount(context.Background(), 1); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("authorizeAccount() = %v, want ErrUnauthenticated", err)
+	}
+}
+
+func TestAPIKeyPrefixOf(t *testing.T) {
+	key, prefix, err := newAPIKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := apiKeyPrefixOf(key); !ok || got != prefix {
+		t.Errorf("apiKeyPrefixOf(%q) = %q, %v, want %q", key, got, ok, prefix)
+	}
+	for _, key := range []string{"", "uk_", prefix, prefix + "_", "xx" + key[2:], key[:len(prefix)] + "-secret"} {
+		if _, ok := apiKeyPrefixOf(key); ok {
+			t.Errorf("apiKeyPrefixOf(%q) ok", key)
+		}
+	}
+}
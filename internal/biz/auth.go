@@ -0,0 +1,196 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "user/api/user/v1"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	minPasswordLen = 8
+	maxPasswordLen = 128
+)
+
+var (
+	// ErrInvalidCredentials is a wrong email or password.
+	ErrInvalidCredentials = errors.Unauthorized(v1.ErrorReason_INVALID_CREDENTIALS.String(), "invalid email or password")
+	// ErrCredentialNotFound is a user without a password.
+	ErrCredentialNotFound = errors.NotFound(v1.ErrorReason_INVALID_CREDENTIALS.String(), "credential not found")
+	// ErrInvalidPassword is a password that does not meet the policy.
+	ErrInvalidPassword = errors.BadRequest(v1.ErrorReason_INVALID_PASSWORD.String(), "password must be between 8 and 128 characters")
+	// ErrPasswordTooLong is a password longer than bcrypt hashes, when
+	// passwords are hashed with bcrypt.
+	ErrPasswordTooLong = errors.BadRequest(v1.ErrorReason_INVALID_PASSWORD.String(), "password must be at most 72 bytes")
+)
+
+// Credential is the password of a User.
+type Credential struct {
+	UserID       int64
+	PasswordHash string
+}
+
+// CredentialRepo is a Credential repo.
+type CredentialRepo interface {
+	Save(context.Context, *Credential) error
+	Update(context.Context, *Credential) error
+	FindByUserID(context.Context, int64) (*Credential, error)
+}
+
+// AuthUsecase is an authentication usecase.
+type AuthUsecase struct {
+	users         UserRepo
+	creds         CredentialRepo
+	refreshTokens RefreshTokenRepo
+	handles       *Handles
+	verification  *VerificationUsecase
+	twoFactor     *TwoFactor
+	sessions      *Sessions
+	apiKeys       *APIKeys
+	tx            Transaction
+	hasher        *PasswordHasher
+	tokens        *TokenManager
+	refreshTTL    time.Duration
+	// dummyHash is verified against when the user does not exist, so that
+	// unknown emails take as long to reject as wrong passwords.
+	dummyHash string
+	log       *log.Helper
+}
+
+// NewAuthUsecase new an authentication usecase.
+func NewAuthUsecase(c *conf.Auth, users UserRepo, creds CredentialRepo, refreshTokens RefreshTokenRepo, handles *Handles, verification *VerificationUsecase, twoFactor *TwoFactor, sessions *Sessions, apiKeys *APIKeys, tx Transaction, hasher *PasswordHasher, tokens *TokenManager, logger log.Logger) (*AuthUsecase, error) {
+	dummy, err := hasher.Hash("not a real password")
+	if err != nil {
+		return nil, err
+	}
+	return &AuthUsecase{
+		users:         users,
+		creds:         creds,
+		refreshTokens: refreshTokens,
+		handles:       handles,
+		verification:  verification,
+		twoFactor:     twoFactor,
+		sessions:      sessions,
+		apiKeys:       apiKeys,
+		tx:            tx,
+		hasher:        hasher,
+		tokens:        tokens,
+		refreshTTL:    refreshTokenTTL(c),
+		dummyHash:     dummy,
+		log:           log.NewHelper(logger),
+	}, nil
+}
+
+// Register creates a User with a password, and returns the new User.
+func (uc *AuthUsecase) Register(ctx context.Context, u *User, password string) (*User, error) {
+	uc.log.WithContext(ctx).Infof("Register: %v", u.Username)
+	u.Email = normalizeEmail(u.Email)
+	if err := validateEmail(u.Email); err != nil {
+		return nil, err
+	}
+	if err := uc.hasher.validate(password); err != nil {
+		return nil, err
+	}
+	handle, err := uc.handles.normalize(u.Username)
+	if err != nil {
+		return nil, err
+	}
+	u.Username = handle
+	hash, err := uc.hasher.Hash(password)
+	if err != nil {
+		return nil, err
+	}
+	var nu *User
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.handles.checkAvailable(ctx, 0, u.Username); err != nil {
+			return err
+		}
+		if nu, err = uc.users.Save(ctx, u); err != nil {
+			return err
+		}
+		return uc.creds.Save(ctx, &Credential{UserID: nu.ID, PasswordHash: hash})
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.verification.sendVerification(ctx, nu)
+	return nu, nil
+}
+
+// Login checks an email and password, and returns the matching User with
+// the tokens of a new session, or, if the user enabled TOTP, only the
+// Challenge to complete with VerifySecondFactor.
+func (uc *AuthUsecase) Login(ctx context.Context, email, password string) (*User, *Tokens, *Challenge, error) {
+	u, err := uc.checkPassword(ctx, email, password)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return uc.completeLogin(ctx, u)
+}
+
+// completeLogin starts a session for u, who proved their first factor, or
+// challenges them for their second one.
+func (uc *AuthUsecase) completeLogin(ctx context.Context, u *User) (*User, *Tokens, *Challenge, error) {
+	on, err := uc.twoFactor.enabled(ctx, u.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if on {
+		c, err := uc.twoFactor.challenge(ctx, u.ID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return nil, nil, c, nil
+	}
+	t, err := uc.startSession(ctx, u.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return u, t, nil, nil
+}
+
+// checkPassword returns the User of email if password is theirs.
+func (uc *AuthUsecase) checkPassword(ctx context.Context, email, password string) (*User, error) {
+	u, err := uc.users.FindByEmail(ctx, normalizeEmail(email))
+	if errors.Is(err, ErrUserNotFound) {
+		uc.hasher.Verify(password, uc.dummyHash)
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+	c, err := uc.creds.FindByUserID(ctx, u.ID)
+	if errors.Is(err, ErrCredentialNotFound) {
+		uc.hasher.Verify(password, uc.dummyHash)
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+	ok, rehash, err := uc.hasher.Verify(password, c.PasswordHash)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrInvalidCredentials
+	}
+	if rehash {
+		uc.rehash(ctx, u.ID, password)
+	}
+	return u, nil
+}
+
+// rehash upgrades the stored hash to the configured parameters. Failing to
+// do so must not fail the login, the next one will try again.
+func (uc *AuthUsecase) rehash(ctx context.Context, userID int64, password string) {
+	hash, err := uc.hasher.Hash(password)
+	if err == nil {
+		err = uc.creds.Update(ctx, &Credential{UserID: userID, PasswordHash: hash})
+	}
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("rehash password of user %d: %v", userID, err)
+	}
+}
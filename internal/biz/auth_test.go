@@ -0,0 +1,213 @@
+package biz_test
+
+import (
+	"context"
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// login logs u in with the password of register.
+func (a *testApp) login(t *testing.T, u *biz.User) *biz.Tokens {
+	t.Helper()
+	_, tokens, _, err := a.auth.Login(context.Background(), u.Email, "password123")
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	return tokens
+}
+
+// asSession returns a context authenticated as u in the session of tokens.
+func asSession(u *biz.User, tokens *biz.Tokens) context.Context {
+	return biz.NewPrincipalContext(context.Background(), &biz.Principal{UserID: u.ID, SessionID: tokens.SessionID})
+}
+
+func TestRevokeAllOtherSessions(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	current, other := a.login(t, jane), a.login(t, jane)
+	ctx := asSession(jane, current)
+
+	if n, err := a.auth.RevokeAllOtherSessions(ctx); err != nil || n != 1 {
+		t.Fatalf("RevokeAllOtherSessions() = %d, %v, want 1", n, err)
+	}
+	ss, id, err := a.auth.ListSessions(ctx)
+	if err != nil || len(ss) != 1 || ss[0].ID != current.SessionID || id != current.SessionID {
+		t.Errorf("ListSessions() = %v, %s, %v, want only the current session", ss, id, err)
+	}
+	p, _ := biz.PrincipalFromContext(ctx)
+	if err := a.sessions.Check(ctx, p); err != nil {
+		t.Errorf("Check() of the current session = %v", err)
+	}
+	if err := a.sessions.Check(ctx, &biz.Principal{UserID: jane.ID, SessionID: other.SessionID}); !errors.Is(err, biz.ErrSessionRevoked) {
+		t.Errorf("Check() of a revoked session = %v, want ErrSessionRevoked", err)
+	}
+	if _, err := a.auth.RefreshToken(ctx, other.RefreshToken); !errors.Is(err, biz.ErrRefreshTokenInvalid) {
+		t.Errorf("RefreshToken() of a revoked session = %v, want ErrRefreshTokenInvalid", err)
+	}
+	if _, err := a.auth.RefreshToken(ctx, current.RefreshToken); err != nil {
+		t.Errorf("RefreshToken() of the current session = %v", err)
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	joe := a.register(t, "joe", "joe@example.com", true)
+	tokens := a.login(t, jane)
+
+	if err := a.auth.RevokeSession(asSession(joe, a.login(t, joe)), tokens.SessionID); !errors.Is(err, biz.ErrSessionNotFound) {
+		t.Errorf("RevokeSession() of another user's session = %v, want ErrSessionNotFound", err)
+	}
+	ctx := asSession(jane, tokens)
+	if err := a.auth.RevokeSession(ctx, tokens.SessionID); err != nil {
+		t.Fatalf("RevokeSession() error = %v", err)
+	}
+	p, _ := biz.PrincipalFromContext(ctx)
+	if err := a.sessions.Check(ctx, p); !errors.Is(err, biz.ErrSessionRevoked) {
+		t.Errorf("Check() of the revoked session = %v, want ErrSessionRevoked", err)
+	}
+}
+
+// totpNow returns the code of secret at the time step off steps from now.
+func totpNow(t *testing.T, secret string, off int64) string {
+	t.Helper()
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return biz.TOTPCode(key, time.Now().Unix()/30+off)
+}
+
+// enableTOTP enrolls and confirms a TOTP for the user of ctx, and returns
+// its secret and recovery codes.
+func (a *testApp) enableTOTP(t *testing.T, ctx context.Context) (string, []string) {
+	t.Helper()
+	secret, _, err := a.auth.EnrollTOTP(ctx)
+	if err != nil {
+		t.Fatalf("EnrollTOTP() error = %v", err)
+	}
+	codes, err := a.auth.ConfirmTOTP(ctx, totpNow(t, secret, 0))
+	if err != nil {
+		t.Fatalf("ConfirmTOTP() error = %v", err)
+	}
+	return secret, codes
+}
+
+// challenge logs u in up to their second factor.
+func (a *testApp) challenge(t *testing.T, u *biz.User) string {
+	t.Helper()
+	_, _, c, err := a.auth.Login(context.Background(), u.Email, "password123")
+	if err != nil || c == nil {
+		t.Fatalf("Login() = %v, %v, want a challenge", c, err)
+	}
+	return c.Token
+}
+
+func TestTOTPStepReuse(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	ctx := asSession(jane, a.login(t, jane))
+	secret, _ := a.enableTOTP(t, ctx)
+
+	// The confirming code is used, and so are the ones of earlier steps.
+	for _, off := range []int64{0, -1} {
+		if err := a.auth.DisableTOTP(ctx, totpNow(t, secret, off)); !errors.Is(err, biz.ErrSecondFactorInvalid) {
+			t.Errorf("DisableTOTP() with the code of step %+d = %v, want ErrSecondFactorInvalid", off, err)
+		}
+	}
+	code := totpNow(t, secret, 1)
+	if _, _, err := a.auth.VerifySecondFactor(context.Background(), a.challenge(t, jane), code); err != nil {
+		t.Fatalf("VerifySecondFactor() with the code of the next step = %v", err)
+	}
+	if _, _, err := a.auth.VerifySecondFactor(context.Background(), a.challenge(t, jane), code); !errors.Is(err, biz.ErrSecondFactorInvalid) {
+		t.Errorf("VerifySecondFactor() with a used code = %v, want ErrSecondFactorInvalid", err)
+	}
+}
+
+func TestRecoveryCodeSingleUse(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	ctx := asSession(jane, a.login(t, jane))
+	_, codes := a.enableTOTP(t, ctx)
+
+	if _, tokens, err := a.auth.VerifySecondFactor(context.Background(), a.challenge(t, jane), codes[0]); err != nil || tokens == nil {
+		t.Fatalf("VerifySecondFactor() with a recovery code = %v, %v", tokens, err)
+	}
+	if _, _, err := a.auth.VerifySecondFactor(context.Background(), a.challenge(t, jane), codes[0]); !errors.Is(err, biz.ErrSecondFactorInvalid) {
+		t.Errorf("VerifySecondFactor() with a used recovery code = %v, want ErrSecondFactorInvalid", err)
+	}
+	// Recovery codes are read back without their dashes or case.
+	if err := a.auth.DisableTOTP(ctx, strings.ToUpper(strings.ReplaceAll(codes[1], "-", " "))); err != nil {
+		t.Fatalf("DisableTOTP() with a recovery code = %v", err)
+	}
+	if _, _, c, err := a.auth.Login(context.Background(), jane.Email, "password123"); err != nil || c != nil {
+		t.Errorf("Login() after DisableTOTP() = %v, %v, want no challenge", c, err)
+	}
+}
+
+func TestDisableTOTPLocked(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	ctx := asSession(jane, a.login(t, jane))
+	secret, codes := a.enableTOTP(t, ctx)
+
+	for i := 0; i < 5; i++ {
+		if err := a.auth.DisableTOTP(ctx, "000000-wrong"); !errors.Is(err, biz.ErrSecondFactorInvalid) {
+			t.Fatalf("DisableTOTP() with a wrong code = %v, want ErrSecondFactorInvalid", err)
+		}
+	}
+	if err := a.auth.DisableTOTP(ctx, totpNow(t, secret, 1)); !errors.Is(err, biz.ErrSecondFactorLocked) {
+		t.Fatalf("DisableTOTP() after 5 wrong codes = %v, want ErrSecondFactorLocked", err)
+	}
+	// Logins are not locked, or guessing would lock the user out.
+	if _, _, err := a.auth.VerifySecondFactor(context.Background(), a.challenge(t, jane), totpNow(t, secret, 1)); err != nil {
+		t.Errorf("VerifySecondFactor() while locked = %v", err)
+	}
+
+	// The lockout ends totpLockout after the latest wrong code.
+	if _, err := a.totpRepo.AddFailedAttempt(context.Background(), jane.ID, time.Now().Add(-time.Hour), time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.auth.DisableTOTP(ctx, codes[0]); err != nil {
+		t.Errorf("DisableTOTP() after the lockout = %v", err)
+	}
+}
+
+func TestConfirmTOTPLocked(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	ctx := asSession(jane, a.login(t, jane))
+	secret, _, err := a.auth.EnrollTOTP(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		if _, err := a.auth.ConfirmTOTP(ctx, "000000"); !errors.Is(err, biz.ErrSecondFactorInvalid) {
+			t.Fatalf("ConfirmTOTP() with a wrong code = %v, want ErrSecondFactorInvalid", err)
+		}
+	}
+	if _, err := a.auth.ConfirmTOTP(ctx, totpNow(t, secret, 0)); !errors.Is(err, biz.ErrSecondFactorLocked) {
+		t.Errorf("ConfirmTOTP() after 5 wrong codes = %v, want ErrSecondFactorLocked", err)
+	}
+	// Enrolling again starts afresh.
+	if secret, _, err = a.auth.EnrollTOTP(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.auth.ConfirmTOTP(ctx, totpNow(t, secret, 0)); err != nil {
+		t.Errorf("ConfirmTOTP() of a new enrollment = %v", err)
+	}
+}
+
+func TestRegisterPasswordTooLong(t *testing.T) {
+	a := newTestApp(t, nil)
+	// 40 characters, but 80 bytes, past what bcrypt hashes.
+	_, err := a.auth.Register(context.Background(), &biz.User{Username: "jane", Email: "jane@example.com"}, strings.Repeat("é", 40))
+	if !errors.Is(err, biz.ErrPasswordTooLong) {
+		t.Errorf("Register() with an 80 byte password = %v, want ErrPasswordTooLong", err)
+	}
+}
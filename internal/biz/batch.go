@@ -0,0 +1,165 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "user/api/user/v1"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// maxBatchSize bounds the keys of a batch lookup.
+const maxBatchSize = 500
+
+// ErrBatchTooLarge is a batch lookup of more than maxBatchSize keys.
+var ErrBatchTooLarge = errors.BadRequest(v1.ErrorReason_BATCH_TOO_LARGE.String(), "batch has more than 500 keys")
+
+// UserByHandle is a User found by a handle, which Redirected reports it gave
+// up within its grace period.
+type UserByHandle struct {
+	Handle     string
+	User       *User
+	Redirected bool
+}
+
+// BatchGetUsers returns the Users with the given ids in the order of ids,
+// without duplicates, and the ids without one. Users blocking the caller
+// are reported missing.
+func (uc *UserUsecase) BatchGetUsers(ctx context.Context, ids []int64) ([]*User, []int64, error) {
+	if len(ids) > maxBatchSize {
+		return nil, nil, ErrBatchTooLarge
+	}
+	seen := make(map[int64]bool, len(ids))
+	unique := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	found, err := uc.repo.FindByIDs(ctx, unique)
+	if err != nil {
+		return nil, nil, err
+	}
+	byID, err := uc.visibleUsers(ctx, found)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		us      = make([]*User, 0, len(byID))
+		missing []int64
+	)
+	for _, id := range unique {
+		if u, ok := byID[id]; ok {
+			us = append(us, u)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	return us, missing, nil
+}
+
+// BatchGetUsersByHandle returns the Users with the given handles in the
+// order of handles, without duplicates, and the handles without one. Like
+// GetUserByHandle it follows the handles given up within their grace period.
+// Users blocking the caller are reported missing.
+func (uc *UserUsecase) BatchGetUsersByHandle(ctx context.Context, handles []string) ([]*UserByHandle, []string, error) {
+	if len(handles) > maxBatchSize {
+		return nil, nil, ErrBatchTooLarge
+	}
+	keys := make([]string, 0, len(handles))
+	seen := make(map[string]bool, len(handles))
+	for _, h := range handles {
+		if k := HandleKey(h); !seen[k] {
+			seen[k] = true
+			keys = append(keys, k)
+		}
+	}
+	current, err := uc.repo.FindByHandles(ctx, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	byKey := make(map[string]*User, len(current))
+	for _, u := range current {
+		byKey[HandleKey(u.Username)] = u
+	}
+	var unresolved []string
+	for _, k := range keys {
+		if byKey[k] == nil {
+			unresolved = append(unresolved, k)
+		}
+	}
+	redirects := make(map[string]int64)
+	if len(unresolved) > 0 {
+		changes, err := uc.handles.repo.FindAll(ctx, unresolved, time.Now())
+		if err != nil {
+			return nil, nil, err
+		}
+		ids := make([]int64, 0, len(changes))
+		for _, c := range changes {
+			redirects[HandleKey(c.Handle)] = c.UserID
+			ids = append(ids, c.UserID)
+		}
+		if len(ids) > 0 {
+			former, err := uc.repo.FindByIDs(ctx, ids)
+			if err != nil {
+				return nil, nil, err
+			}
+			current = append(current, former...)
+		}
+	}
+	byID, err := uc.visibleUsers(ctx, current)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		us      = make([]*UserByHandle, 0, len(keys))
+		missing []string
+	)
+	seen = make(map[string]bool, len(handles))
+	for _, h := range handles {
+		k := HandleKey(h)
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		if u := byKey[k]; u != nil && byID[u.ID] != nil {
+			us = append(us, &UserByHandle{Handle: h, User: u})
+		} else if u := byID[redirects[k]]; u != nil {
+			us = append(us, &UserByHandle{Handle: h, User: u, Redirected: true})
+		} else {
+			missing = append(missing, h)
+		}
+	}
+	return us, missing, nil
+}
+
+// visibleUsers indexes users by id, leaving out those blocking the
+// authenticated user.
+func (uc *UserUsecase) visibleUsers(ctx context.Context, users []*User) (map[int64]*User, error) {
+	byID := make(map[int64]*User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+	viewerID, ok := UserIDFromContext(ctx)
+	if !ok || len(byID) == 0 {
+		return byID, nil
+	}
+	ids := make([]int64, 0, len(byID))
+	for id := range byID {
+		if id != viewerID {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return byID, nil
+	}
+	blockers, err := uc.blocks.Blockers(ctx, viewerID, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range blockers {
+		delete(byID, id)
+	}
+	return byID, nil
+}
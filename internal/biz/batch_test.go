@@ -0,0 +1,94 @@
+package biz_test
+
+import (
+	"fmt"
+	"testing"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestBatchGetUsers(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	joe := a.register(t, "joe", "joe@example.com", true)
+	ann := a.register(t, "ann", "ann@example.com", true)
+	max := a.register(t, "max", "max@example.com", true)
+	if err := a.graph.Block(as(max), jane.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Users keep the order asked for, once each, and the missing ones
+	// include those blocking the caller.
+	us, missing, err := a.user.BatchGetUsers(as(jane), []int64{ann.ID, 9999, jane.ID, ann.ID, max.ID, joe.ID})
+	if err != nil {
+		t.Fatalf("BatchGetUsers() error = %v", err)
+	}
+	if got, want := userIDs(us), []int64{ann.ID, jane.ID, joe.ID}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("BatchGetUsers() = %v, want %v", got, want)
+	}
+	if want := []int64{9999, max.ID}; fmt.Sprint(missing) != fmt.Sprint(want) {
+		t.Errorf("BatchGetUsers() missing = %v, want %v", missing, want)
+	}
+
+	ids := make([]int64, 501)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+	if _, _, err := a.user.BatchGetUsers(as(jane), ids[:500]); err != nil {
+		t.Errorf("BatchGetUsers() of 500 ids = %v", err)
+	}
+	if _, _, err := a.user.BatchGetUsers(as(jane), ids); !errors.Is(err, biz.ErrBatchTooLarge) {
+		t.Errorf("BatchGetUsers() of 501 ids = %v, want ErrBatchTooLarge", err)
+	}
+}
+
+func TestBatchGetUsersByHandle(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	joe := a.register(t, "joe", "joe@example.com", true)
+	max := a.register(t, "max", "max@example.com", true)
+	if _, err := a.user.ChangeHandle(as(joe), joe.ID, "joseph"); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.graph.Block(as(max), jane.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	us, missing, err := a.user.BatchGetUsersByHandle(as(jane), []string{"JOE", "nobody", "Jane", "jane", "max", "joseph"})
+	if err != nil {
+		t.Fatalf("BatchGetUsersByHandle() error = %v", err)
+	}
+	want := []biz.UserByHandle{
+		{Handle: "JOE", User: joe, Redirected: true},
+		{Handle: "Jane", User: jane},
+		{Handle: "joseph", User: joe},
+	}
+	if len(us) != len(want) {
+		t.Fatalf("BatchGetUsersByHandle() = %d users, want %d", len(us), len(want))
+	}
+	for i, w := range want {
+		if us[i].Handle != w.Handle || us[i].User.ID != w.User.ID || us[i].Redirected != w.Redirected {
+			t.Errorf("BatchGetUsersByHandle()[%d] = %s %d %t, want %s %d %t", i, us[i].Handle, us[i].User.ID, us[i].Redirected, w.Handle, w.User.ID, w.Redirected)
+		}
+	}
+	if fmt.Sprint(missing) != "[nobody max]" {
+		t.Errorf("BatchGetUsersByHandle() missing = %v, want [nobody max]", missing)
+	}
+
+	handles := make([]string, 501)
+	for i := range handles {
+		handles[i] = fmt.Sprintf("user%d", i)
+	}
+	if _, _, err := a.user.BatchGetUsersByHandle(as(jane), handles); !errors.Is(err, biz.ErrBatchTooLarge) {
+		t.Errorf("BatchGetUsersByHandle() of 501 handles = %v, want ErrBatchTooLarge", err)
+	}
+}
+
+func userIDs(us []*biz.User) []int64 {
+	ids := make([]int64, len(us))
+	for i, u := range us {
+		ids[i] = u.ID
+	}
+	return ids
+}
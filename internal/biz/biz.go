@@ -1,6 +1,16 @@
 package biz
 
-import "github.com/google/wire"
+import (
+	"context"
+
+	"github.com/google/wire"
+)
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewGreeterUsecase)
+var ProviderSet = wire.NewSet(NewUserUsecase, NewAuthUsecase, NewGraphUsecase, NewPasswordHasher, NewTokenManager, NewPaginator, NewHandles, NewTwoFactor, NewSessions, NewVerificationUsecase, NewPasswordResetUsecase, NewOAuthUsecase, NewFederationUsecase, NewAPIKeys)
+
+// Transaction runs fn atomically. Repo calls made with the context passed
+// to fn take part in the transaction.
+type Transaction interface {
+	ExecTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,192 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "user/api/user/v1"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	// ErrCannotBlockSelf is a user blocking themselves.
+	ErrCannotBlockSelf = errors.BadRequest(v1.ErrorReason_CANNOT_BLOCK_SELF.String(), "cannot block yourself")
+	// ErrAlreadyBlocked is a block that already exists.
+	ErrAlreadyBlocked = errors.Conflict(v1.ErrorReason_ALREADY_BLOCKED.String(), "already blocking user")
+	// ErrNotBlocked is a block that does not exist.
+	ErrNotBlocked = errors.NotFound(v1.ErrorReason_NOT_BLOCKED.String(), "not blocking user")
+	// ErrCannotMuteSelf is a user muting themselves.
+	ErrCannotMuteSelf = errors.BadRequest(v1.ErrorReason_CANNOT_MUTE_SELF.String(), "cannot mute yourself")
+	// ErrAlreadyMuted is a mute that already exists.
+	ErrAlreadyMuted = errors.Conflict(v1.ErrorReason_ALREADY_MUTED.String(), "already muting user")
+	// ErrNotMuted is a mute that does not exist.
+	ErrNotMuted = errors.NotFound(v1.ErrorReason_NOT_MUTED.String(), "not muting user")
+	// ErrUserBlocked is an action forbidden by a block between two users.
+	ErrUserBlocked = errors.Forbidden(v1.ErrorReason_USER_BLOCKED.String(), "user is blocked")
+)
+
+// Block is BlockerID blocking BlockedID. A block severs the follows between
+// the two users and keeps them from following each other, and keeps the
+// blocked user from viewing the blocker.
+type Block struct {
+	BlockerID int64
+	BlockedID int64
+	CreatedAt time.Time
+}
+
+// BlockRepo is a Block repo.
+type BlockRepo interface {
+	// Save stores a block, and returns ErrAlreadyBlocked if it exists.
+	Save(context.Context, *Block) (*Block, error)
+	// Delete removes a block, and returns ErrNotBlocked if it does not
+	// exist.
+	Delete(ctx context.Context, blockerID, blockedID int64) error
+	Exists(ctx context.Context, blockerID, blockedID int64) (bool, error)
+	// Blockers returns which of blockerIDs block blockedID.
+	Blockers(ctx context.Context, blockedID int64, blockerIDs []int64) ([]int64, error)
+}
+
+// Mute is MuterID muting MutedID. A mute only hides the muted user from the
+// feed of the muter, who is not told about it.
+type Mute struct {
+	MuterID   int64
+	MutedID   int64
+	CreatedAt time.Time
+}
+
+// MuteRepo is a Mute repo.
+type MuteRepo interface {
+	// Save stores a mute, and returns ErrAlreadyMuted if it exists.
+	Save(context.Context, *Mute) (*Mute, error)
+	// Delete removes a mute, and returns ErrNotMuted if it does not exist.
+	Delete(ctx context.Context, muterID, mutedID int64) error
+	Exists(ctx context.Context, muterID, mutedID int64) (bool, error)
+}
+
+// Visibility is what a viewer may see of a target user.
+type Visibility struct {
+	// Visible is whether the viewer may see the target and their content,
+	// which a block in either direction prevents.
+	Visible bool
+	// Blocked is whether the target blocks the viewer.
+	Blocked bool
+	// Blocking is whether the viewer blocks the target.
+	Blocking bool
+	// Muted is whether the viewer mutes the target.
+	Muted bool
+}
+
+// Block makes the authenticated user block blockedID, and removes the
+// follows and follow requests between them.
+func (uc *GraphUsecase) Block(ctx context.Context, blockedID int64) error {
+	blockerID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	if blockerID == blockedID {
+		return ErrCannotBlockSelf
+	}
+	if _, err := uc.users.FindByID(ctx, blockedID); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("Block: %d -> %d", blockerID, blockedID)
+	return uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.blocks.Save(ctx, &Block{BlockerID: blockerID, BlockedID: blockedID}); err != nil {
+			return err
+		}
+		for _, f := range [][2]int64{{blockerID, blockedID}, {blockedID, blockerID}} {
+			if err := uc.repo.Delete(ctx, f[0], f[1]); err != nil && !errors.Is(err, ErrNotFollowing) {
+				return err
+			}
+			if err := uc.requests.Delete(ctx, f[0], f[1]); err != nil && !errors.Is(err, ErrFollowRequestNotFound) {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Unblock makes the authenticated user stop blocking blockedID. Severed
+// follows are not restored.
+func (uc *GraphUsecase) Unblock(ctx context.Context, blockedID int64) error {
+	blockerID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	uc.log.WithContext(ctx).Infof("Unblock: %d -> %d", blockerID, blockedID)
+	return uc.blocks.Delete(ctx, blockerID, blockedID)
+}
+
+// Mute makes the authenticated user mute mutedID.
+func (uc *GraphUsecase) Mute(ctx context.Context, mutedID int64) error {
+	muterID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	if muterID == mutedID {
+		return ErrCannotMuteSelf
+	}
+	if _, err := uc.users.FindByID(ctx, mutedID); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("Mute: %d -> %d", muterID, mutedID)
+	_, err := uc.mutes.Save(ctx, &Mute{MuterID: muterID, MutedID: mutedID})
+	return err
+}
+
+// Unmute makes the authenticated user stop muting mutedID.
+func (uc *GraphUsecase) Unmute(ctx context.Context, mutedID int64) error {
+	muterID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	uc.log.WithContext(ctx).Infof("Unmute: %d -> %d", muterID, mutedID)
+	return uc.mutes.Delete(ctx, muterID, mutedID)
+}
+
+// CheckVisibility returns what viewerID may see of targetID.
+func (uc *GraphUsecase) CheckVisibility(ctx context.Context, viewerID, targetID int64) (*Visibility, error) {
+	if viewerID == targetID {
+		return &Visibility{Visible: true}, nil
+	}
+	var (
+		v   Visibility
+		err error
+	)
+	if v.Blocked, err = uc.blocks.Exists(ctx, targetID, viewerID); err != nil {
+		return nil, err
+	}
+	if v.Blocking, err = uc.blocks.Exists(ctx, viewerID, targetID); err != nil {
+		return nil, err
+	}
+	if v.Muted, err = uc.mutes.Exists(ctx, viewerID, targetID); err != nil {
+		return nil, err
+	}
+	v.Visible = !v.Blocked && !v.Blocking
+	return &v, nil
+}
+
+// blockedBetween reports whether either user blocks the other.
+func blockedBetween(ctx context.Context, blocks BlockRepo, a, b int64) (bool, error) {
+	if ok, err := blocks.Exists(ctx, a, b); err != nil || ok {
+		return ok, err
+	}
+	return blocks.Exists(ctx, b, a)
+}
+
+// checkViewable returns ErrUserBlocked if targetID blocks the
+// authenticated user.
+func checkViewable(ctx context.Context, blocks BlockRepo, targetID int64) error {
+	viewerID, ok := UserIDFromContext(ctx)
+	if !ok || viewerID == targetID {
+		return nil
+	}
+	blocked, err := blocks.Exists(ctx, targetID, viewerID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return ErrUserBlocked
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+package biz
+
+// Exported to the external tests.
+var (
+	TOTPCode  = totpCode
+	HashToken = hashToken
+)
@@ -0,0 +1,418 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	v1 "user/api/user/v1"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	federatedStateBytes    = 32
+	federatedNonceBytes    = 32
+	federatedVerifierBytes = 32
+
+	defaultFederatedStateTTL = 10 * time.Minute
+
+	// federatedHandleAttempts bounds the handles tried for a new user when
+	// the one derived from their identity is taken.
+	federatedHandleAttempts = 5
+)
+
+var (
+	// ErrIdentityProviderNotFound is a provider missing from the federation
+	// config.
+	ErrIdentityProviderNotFound = errors.NotFound(v1.ErrorReason_IDENTITY_PROVIDER_NOT_FOUND.String(), "identity provider not found")
+	// ErrFederatedLoginInvalid is a state that is unknown, used, expired or
+	// of another provider.
+	ErrFederatedLoginInvalid = errors.BadRequest(v1.ErrorReason_FEDERATED_LOGIN_INVALID.String(), "federated login is invalid or has expired")
+	// ErrFederatedLoginFailed is a login the provider refused, or whose
+	// identity could not be verified.
+	ErrFederatedLoginFailed = errors.Unauthorized(v1.ErrorReason_FEDERATED_LOGIN_FAILED.String(), "identity provider did not authenticate the user")
+	// ErrFederatedEmailUnverified is a new identity without an email the
+	// provider verified, which it cannot be linked nor signed up by.
+	ErrFederatedEmailUnverified = errors.Forbidden(v1.ErrorReason_FEDERATED_EMAIL_UNVERIFIED.String(), "identity provider did not verify an email")
+	// ErrFederatedAccountConflict is a new identity whose email is the one
+	// of a user it cannot be linked to: one who has not verified it, or who
+	// has another identity of the provider.
+	ErrFederatedAccountConflict = errors.Conflict(v1.ErrorReason_FEDERATED_ACCOUNT_CONFLICT.String(), "email belongs to an account the identity cannot be linked to")
+	// ErrLinkedIdentityNotFound is linked identity not found.
+	ErrLinkedIdentityNotFound = errors.NotFound(v1.ErrorReason_LINKED_IDENTITY_NOT_FOUND.String(), "linked identity not found")
+	// ErrLastLoginMethod is unlinking the only identity of a user without a
+	// password.
+	ErrLastLoginMethod = errors.Conflict(v1.ErrorReason_LAST_LOGIN_METHOD.String(), "cannot unlink the only way to log in, set a password first")
+)
+
+// ExternalIdentity is a user as authenticated by an IdentityProvider.
+type ExternalIdentity struct {
+	// Subject is the stable identifier of the user at the provider.
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+}
+
+// IdentityProvider is an upstream OpenID Connect provider users log in
+// with, through the authorization code flow with PKCE.
+type IdentityProvider interface {
+	// AuthorizationURL returns the authorization endpoint of the provider
+	// with the parameters of a login request.
+	AuthorizationURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	// Exchange redeems an authorization code, and returns the identity of
+	// its verified ID token, whose nonce must be nonce.
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*ExternalIdentity, error)
+}
+
+// IdentityProviders are the configured providers by name.
+type IdentityProviders map[string]IdentityProvider
+
+// LinkedIdentity is the identity of a user at a provider. A user has at
+// most one per provider.
+type LinkedIdentity struct {
+	UserID   int64
+	Provider string
+	Subject  string
+	// Email is the one the provider asserted at the latest login.
+	Email       string
+	CreatedAt   time.Time
+	LastLoginAt time.Time
+}
+
+// LinkedIdentityRepo is a LinkedIdentity repo.
+type LinkedIdentityRepo interface {
+	// Save links an identity, or returns ErrFederatedAccountConflict if the
+	// identity or the user already has one of the provider.
+	Save(context.Context, *LinkedIdentity) (*LinkedIdentity, error)
+	// Find returns the identity of subject at provider, or
+	// ErrLinkedIdentityNotFound.
+	Find(ctx context.Context, provider, subject string) (*LinkedIdentity, error)
+	// List returns the identities of a user ordered by provider.
+	List(ctx context.Context, userID int64) ([]*LinkedIdentity, error)
+	// Touch records a login of an identity with the email it asserted.
+	Touch(ctx context.Context, provider, subject, email string, at time.Time) error
+	// Delete unlinks the identity of a user at provider, or returns
+	// ErrLinkedIdentityNotFound.
+	Delete(ctx context.Context, userID int64, provider string) error
+}
+
+// FederatedLogin is a login started at a provider and not yet completed.
+// Only the hash of its state is stored.
+type FederatedLogin struct {
+	StateHash    string
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+// FederatedLoginRepo is a FederatedLogin repo.
+type FederatedLoginRepo interface {
+	// Save stores a login, and purges the expired ones.
+	Save(context.Context, *FederatedLogin) error
+	// Take removes and returns the login of a state hash, or returns
+	// ErrFederatedLoginInvalid, so that every state is used once.
+	Take(ctx context.Context, hash string) (*FederatedLogin, error)
+}
+
+// FederationUsecase logs users in with upstream OpenID Connect providers,
+// linking their identities to the users of the same verified email, or
+// signing them up.
+type FederationUsecase struct {
+	providers  IdentityProviders
+	identities LinkedIdentityRepo
+	logins     FederatedLoginRepo
+	users      UserRepo
+	creds      CredentialRepo
+	handles    *Handles
+	auth       *AuthUsecase
+	tx         Transaction
+	stateTTL   time.Duration
+	log        *log.Helper
+}
+
+// NewFederationUsecase new a FederationUsecase.
+func NewFederationUsecase(c *conf.Federation, providers IdentityProviders, identities LinkedIdentityRepo, logins FederatedLoginRepo, users UserRepo, creds CredentialRepo, handles *Handles, auth *AuthUsecase, tx Transaction, logger log.Logger) *FederationUsecase {
+	uc := &FederationUsecase{
+		providers:  providers,
+		identities: identities,
+		logins:     logins,
+		users:      users,
+		creds:      creds,
+		handles:    handles,
+		auth:       auth,
+		tx:         tx,
+		stateTTL:   defaultFederatedStateTTL,
+		log:        log.NewHelper(logger),
+	}
+	if ttl := c.GetStateTtl(); ttl != nil {
+		uc.stateTTL = ttl.AsDuration()
+	}
+	return uc
+}
+
+// StartLogin starts a login at provider, and returns the URL to send the
+// user agent to with the state it comes back with.
+func (uc *FederationUsecase) StartLogin(ctx context.Context, provider string) (string, string, error) {
+	p, ok := uc.providers[provider]
+	if !ok {
+		return "", "", ErrIdentityProviderNotFound
+	}
+	var tokens [3]string
+	for i, n := range []int{federatedStateBytes, federatedNonceBytes, federatedVerifierBytes} {
+		t, err := randomToken(n)
+		if err != nil {
+			return "", "", err
+		}
+		tokens[i] = t
+	}
+	state, nonce, verifier := tokens[0], tokens[1], tokens[2]
+	to, err := p.AuthorizationURL(ctx, state, nonce, codeChallenge(verifier))
+	if err != nil {
+		return "", "", err
+	}
+	err = uc.logins.Save(ctx, &FederatedLogin{
+		StateHash:    hashToken(state),
+		Provider:     provider,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		ExpiresAt:    time.Now().Add(uc.stateTTL),
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return to, state, nil
+}
+
+// FinishLogin completes a login at provider with the code and state it
+// redirected with, or the error it redirected with instead. It returns the
+// user of the identity, created if there was none, with the tokens of a new
+// session or, if the user enabled TOTP, only the Challenge to complete with
+// VerifySecondFactor.
+func (uc *FederationUsecase) FinishLogin(ctx context.Context, provider, code, state, failure string) (u *User, t *Tokens, c *Challenge, created bool, err error) {
+	p, ok := uc.providers[provider]
+	if !ok {
+		return nil, nil, nil, false, ErrIdentityProviderNotFound
+	}
+	login, err := uc.logins.Take(ctx, hashToken(state))
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+	if login.Provider != provider || !login.ExpiresAt.After(time.Now()) {
+		return nil, nil, nil, false, ErrFederatedLoginInvalid
+	}
+	if failure != "" {
+		uc.log.WithContext(ctx).Infof("federated login at %s failed: %s", provider, failure)
+		return nil, nil, nil, false, ErrFederatedLoginFailed
+	}
+	id, err := p.Exchange(ctx, code, login.CodeVerifier, login.Nonce)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("federated login at %s: %v", provider, err)
+		return nil, nil, nil, false, ErrFederatedLoginFailed
+	}
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		u, created, err = uc.link(ctx, provider, id)
+		return err
+	})
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+	uc.log.WithContext(ctx).Infof("FinishFederatedLogin: %d with %s (created %t)", u.ID, provider, created)
+	u, t, c, err = uc.auth.completeLogin(ctx, u)
+	return u, t, c, created, err
+}
+
+// link returns the user of an identity. An unknown identity is linked to
+// the user of its email if they verified it too, else it signs up a user
+// with its email verified and no password.
+func (uc *FederationUsecase) link(ctx context.Context, provider string, id *ExternalIdentity) (*User, bool, error) {
+	email := normalizeEmail(id.Email)
+	li, err := uc.identities.Find(ctx, provider, id.Subject)
+	switch {
+	case err == nil:
+		if err := uc.identities.Touch(ctx, provider, id.Subject, email, time.Now()); err != nil {
+			return nil, false, err
+		}
+		u, err := uc.users.FindByID(ctx, li.UserID)
+		return u, false, err
+	case !errors.Is(err, ErrLinkedIdentityNotFound):
+		return nil, false, err
+	}
+	if validateEmail(email) != nil || !id.EmailVerified {
+		return nil, false, ErrFederatedEmailUnverified
+	}
+	created := false
+	u, err := uc.users.FindByEmail(ctx, email)
+	switch {
+	case err == nil:
+		if !u.EmailVerified {
+			return nil, false, ErrFederatedAccountConflict
+		}
+	case errors.Is(err, ErrUserNotFound):
+		if u, err = uc.signUp(ctx, id, email); err != nil {
+			return nil, false, err
+		}
+		created = true
+	default:
+		return nil, false, err
+	}
+	_, err = uc.identities.Save(ctx, &LinkedIdentity{UserID: u.ID, Provider: provider, Subject: id.Subject, Email: email})
+	if err != nil {
+		return nil, false, err
+	}
+	return u, created, nil
+}
+
+// signUp creates the user of an identity with its verified email. Its
+// handle is derived from the preferred username or the email of the
+// identity, with a random suffix if that one is taken.
+func (uc *FederationUsecase) signUp(ctx context.Context, id *ExternalIdentity, email string) (*User, error) {
+	u := &User{Email: email}
+	if name := truncateRunes(strings.TrimSpace(id.Name), maxDisplayNameLen); name != "" {
+		u.Profile.DisplayName = name
+		if err := u.Profile.normalize(); err != nil {
+			u.Profile = Profile{}
+		}
+	}
+	base := handleOf(id.PreferredUsername)
+	if base == "" {
+		local := email
+		if i := strings.LastIndex(email, "@"); i >= 0 {
+			local = email[:i]
+		}
+		base = handleOf(local)
+	}
+	if base == "" {
+		base = "user"
+	}
+	handle, err := uc.freeHandle(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+	u.Username = handle
+	nu, err := uc.users.Save(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	return uc.users.VerifyEmail(ctx, nu.ID, nu.Email)
+}
+
+// freeHandle returns base if it is a valid handle nobody holds, else base
+// with the first free random suffix.
+func (uc *FederationUsecase) freeHandle(ctx context.Context, base string) (string, error) {
+	candidate := base
+	for i := 0; i < federatedHandleAttempts; i++ {
+		if i > 0 {
+			n, err := rand.Int(rand.Reader, big.NewInt(10000))
+			if err != nil {
+				return "", err
+			}
+			candidate = fmt.Sprintf("%s_%04d", base, n)
+		}
+		handle, err := uc.handles.normalize(candidate)
+		if err != nil {
+			continue
+		}
+		if err := uc.handles.checkAvailable(ctx, 0, handle); errors.Is(err, ErrUsernameTaken) {
+			continue
+		} else if err != nil {
+			return "", err
+		}
+		_, err = uc.users.FindByHandle(ctx, HandleKey(handle))
+		if errors.Is(err, ErrUserNotFound) {
+			return handle, nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return "", ErrUsernameTaken
+}
+
+// handleOf turns a username or email local part into handle characters,
+// short enough to take a suffix, or returns "" if too little is left.
+func handleOf(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+			b.WriteRune(r)
+		case r == '.' || r == '-' || r == '+' || r == ' ':
+			b.WriteRune('_')
+		}
+	}
+	h := truncateRunes(strings.Trim(b.String(), "_"), maxHandleLen-5)
+	if utf8.RuneCountInString(h) < minHandleLen {
+		return ""
+	}
+	return h
+}
+
+// truncateRunes returns the first n runes of s.
+func truncateRunes(s string, n int) string {
+	for i := range s {
+		if n == 0 {
+			return s[:i]
+		}
+		n--
+	}
+	return s
+}
+
+// ListIdentities returns the identities linked to the authenticated user.
+func (uc *FederationUsecase) ListIdentities(ctx context.Context) ([]*LinkedIdentity, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	return uc.identities.List(ctx, userID)
+}
+
+// Unlink unlinks the identity of the authenticated user at provider, unless
+// they have neither a password nor the identity of another configured
+// provider to log in with.
+func (uc *FederationUsecase) Unlink(ctx context.Context, provider string) error {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	return uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.identities.Delete(ctx, userID, provider); err != nil {
+			return err
+		}
+		_, err := uc.creds.FindByUserID(ctx, userID)
+		if errors.Is(err, ErrCredentialNotFound) {
+			ids, err := uc.identities.List(ctx, userID)
+			if err != nil {
+				return err
+			}
+			if !uc.canLogIn(ids) {
+				return ErrLastLoginMethod
+			}
+		} else if err != nil {
+			return err
+		}
+		uc.log.WithContext(ctx).Infof("UnlinkIdentity: %d from %s", userID, provider)
+		return nil
+	})
+}
+
+// canLogIn reports whether one of ids is of a configured provider.
+func (uc *FederationUsecase) canLogIn(ids []*LinkedIdentity) bool {
+	for _, li := range ids {
+		if _, ok := uc.providers[li.Provider]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// fakeProvider is an IdentityProvider asserting id for every code.
+type fakeProvider struct {
+	id *biz.ExternalIdentity
+}
+
+func (p *fakeProvider) AuthorizationURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	return "https://id.example.com/authorize?state=" + state, nil
+}
+
+func (p *fakeProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*biz.ExternalIdentity, error) {
+	if code != "code" {
+		return nil, errors.New(400, "invalid_grant", "bad code")
+	}
+	id := *p.id
+	return &id, nil
+}
+
+// login logs in at the fake provider asserting id.
+func login(t *testing.T, a *testApp, p *fakeProvider, id biz.ExternalIdentity) (*biz.User, bool, error) {
+	t.Helper()
+	ctx := context.Background()
+	p.id = &id
+	_, state, err := a.federation.StartLogin(ctx, "fake")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, tokens, _, created, err := a.federation.FinishLogin(ctx, "fake", "code", state, "")
+	if err == nil && tokens == nil {
+		t.Fatal("FinishLogin() returned no tokens")
+	}
+	return u, created, err
+}
+
+func newFederationApp(t *testing.T) (*testApp, *fakeProvider) {
+	p := &fakeProvider{}
+	return newTestApp(t, biz.IdentityProviders{"fake": p}), p
+}
+
+func TestFederatedLoginSignsUp(t *testing.T) {
+	a, p := newFederationApp(t)
+	u, created, err := login(t, a, p, biz.ExternalIdentity{Subject: "s1", Email: "Jane@Example.com", EmailVerified: true, PreferredUsername: "jane"})
+	if err != nil {
+		t.Fatalf("FinishLogin() error = %v", err)
+	}
+	if !created || u.Username != "jane" || u.Email != "jane@example.com" || !u.EmailVerified {
+		t.Errorf("FinishLogin() = %+v, created %t, want a new verified jane", u, created)
+	}
+	again, created, err := login(t, a, p, biz.ExternalIdentity{Subject: "s1", Email: "jane@example.com", EmailVerified: true})
+	if err != nil {
+		t.Fatalf("second FinishLogin() error = %v", err)
+	}
+	if created || again.ID != u.ID {
+		t.Errorf("second FinishLogin() = %d, created %t, want %d", again.ID, created, u.ID)
+	}
+}
+
+func TestFederatedLoginLinksVerifiedEmail(t *testing.T) {
+	a, p := newFederationApp(t)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	u, created, err := login(t, a, p, biz.ExternalIdentity{Subject: "s1", Email: "jane@example.com", EmailVerified: true})
+	if err != nil {
+		t.Fatalf("FinishLogin() error = %v", err)
+	}
+	if created || u.ID != jane.ID {
+		t.Errorf("FinishLogin() = %d, created %t, want the existing user %d", u.ID, created, jane.ID)
+	}
+	ids, err := a.federation.ListIdentities(as(jane))
+	if err != nil || len(ids) != 1 || ids[0].Subject != "s1" {
+		t.Errorf("ListIdentities() = %v, %v, want the linked identity", ids, err)
+	}
+}
+
+func TestFederatedLoginDoesNotLinkUnverifiedEmail(t *testing.T) {
+	a, p := newFederationApp(t)
+	a.register(t, "jane", "jane@example.com", false)
+	_, _, err := login(t, a, p, biz.ExternalIdentity{Subject: "s1", Email: "jane@example.com", EmailVerified: true})
+	if !errors.Is(err, biz.ErrFederatedAccountConflict) {
+		t.Errorf("FinishLogin() = %v, want ErrFederatedAccountConflict for a user who did not verify the email", err)
+	}
+}
+
+func TestFederatedLoginRequiresVerifiedEmail(t *testing.T) {
+	a, p := newFederationApp(t)
+	a.register(t, "jane", "jane@example.com", true)
+	for _, id := range []biz.ExternalIdentity{
+		{Subject: "s1", Email: "jane@example.com"},
+		{Subject: "s2", Email: "joe@example.com"},
+		{Subject: "s3", EmailVerified: true},
+		{Subject: "s4", Email: "not an email", EmailVerified: true},
+	} {
+		if _, _, err := login(t, a, p, id); !errors.Is(err, biz.ErrFederatedEmailUnverified) {
+			t.Errorf("FinishLogin(%+v) = %v, want ErrFederatedEmailUnverified", id, err)
+		}
+	}
+}
+
+func TestFederatedLoginState(t *testing.T) {
+	a, p := newFederationApp(t)
+	p.id = &biz.ExternalIdentity{Subject: "s1", Email: "jane@example.com", EmailVerified: true}
+	ctx := context.Background()
+	_, state, err := a.federation.StartLogin(ctx, "fake")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, _, err := a.federation.FinishLogin(ctx, "fake", "bad", state, ""); !errors.Is(err, biz.ErrFederatedLoginFailed) {
+		t.Errorf("FinishLogin() with a bad code = %v, want ErrFederatedLoginFailed", err)
+	}
+	if _, _, _, _, err := a.federation.FinishLogin(ctx, "fake", "code", state, ""); !errors.Is(err, biz.ErrFederatedLoginInvalid) {
+		t.Errorf("FinishLogin() with a used state = %v, want ErrFederatedLoginInvalid", err)
+	}
+	if _, _, err := a.federation.StartLogin(ctx, "other"); !errors.Is(err, biz.ErrIdentityProviderNotFound) {
+		t.Errorf("StartLogin() of an unknown provider = %v, want ErrIdentityProviderNotFound", err)
+	}
+}
+
+func TestUnlinkLastLoginMethod(t *testing.T) {
+	a, p := newFederationApp(t)
+	u, _, err := login(t, a, p, biz.ExternalIdentity{Subject: "s1", Email: "jane@example.com", EmailVerified: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.federation.Unlink(as(u), "fake"); !errors.Is(err, biz.ErrLastLoginMethod) {
+		t.Errorf("Unlink() = %v, want ErrLastLoginMethod", err)
+	}
+}
@@ -0,0 +1,173 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "user/api/user/v1"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// followRequestTTL is how long a follow request waits for the private
+// account to answer it.
+const followRequestTTL = 30 * 24 * time.Hour
+
+var (
+	// ErrFollowRequestNotFound is a follow request that does not exist or
+	// has expired.
+	ErrFollowRequestNotFound = errors.NotFound(v1.ErrorReason_FOLLOW_REQUEST_NOT_FOUND.String(), "follow request not found")
+	// ErrAlreadyRequested is a pending follow request that already exists.
+	ErrAlreadyRequested = errors.Conflict(v1.ErrorReason_ALREADY_REQUESTED.String(), "follow already requested")
+)
+
+// FollowRequest is RequesterID asking to follow the private account
+// TargetID.
+type FollowRequest struct {
+	RequesterID int64
+	TargetID    int64
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// FollowRequestRepo is a FollowRequest repo. Expired requests are kept until
+// DeleteExpired, and callers skip them.
+type FollowRequestRepo interface {
+	// Save stores a request, and returns ErrAlreadyRequested if one exists.
+	Save(context.Context, *FollowRequest) (*FollowRequest, error)
+	Find(ctx context.Context, requesterID, targetID int64) (*FollowRequest, error)
+	// Delete removes a request, and returns ErrFollowRequestNotFound if it
+	// does not exist.
+	Delete(ctx context.Context, requesterID, targetID int64) error
+	// List returns a page of the requests to targetID unexpired at at,
+	// newest first. The cursor ID is the requester.
+	List(ctx context.Context, targetID int64, at time.Time, page *Page) ([]*FollowRequest, error)
+	// ListAll returns every request to targetID unexpired at at.
+	ListAll(ctx context.Context, targetID int64, at time.Time) ([]*FollowRequest, error)
+	// DeleteAll removes every request to targetID.
+	DeleteAll(ctx context.Context, targetID int64) error
+	// DeleteExpired removes the requests expired at at, and returns how
+	// many it removed.
+	DeleteExpired(ctx context.Context, at time.Time) (int64, error)
+}
+
+// requestFollow stores a follow request of followerID to the private
+// account followeeID, replacing an expired one. Callers run it in a
+// transaction.
+func (uc *GraphUsecase) requestFollow(ctx context.Context, followerID, followeeID int64) (*FollowRequest, error) {
+	following, err := uc.repo.Exists(ctx, followerID, followeeID)
+	if err != nil {
+		return nil, err
+	}
+	if following {
+		return nil, ErrAlreadyFollowing
+	}
+	t := time.Now()
+	old, err := uc.requests.Find(ctx, followerID, followeeID)
+	switch {
+	case errors.Is(err, ErrFollowRequestNotFound):
+	case err != nil:
+		return nil, err
+	case old.ExpiresAt.After(t):
+		return nil, ErrAlreadyRequested
+	default:
+		if err := uc.requests.Delete(ctx, followerID, followeeID); err != nil {
+			return nil, err
+		}
+	}
+	return uc.requests.Save(ctx, &FollowRequest{
+		RequesterID: followerID,
+		TargetID:    followeeID,
+		ExpiresAt:   t.Add(followRequestTTL),
+	})
+}
+
+// ListFollowRequests lists a page of the pending follow requests to the
+// authenticated user, and returns the token of the next page.
+func (uc *GraphUsecase) ListFollowRequests(ctx context.Context, req PageRequest) ([]*FollowRequest, string, error) {
+	targetID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, "", ErrUnauthenticated
+	}
+	scope := fmt.Sprintf("follow_requests:%d", targetID)
+	page, err := uc.pages.Page(scope, req)
+	if err != nil {
+		return nil, "", err
+	}
+	rs, err := uc.requests.List(ctx, targetID, time.Now(), page)
+	if err != nil {
+		return nil, "", err
+	}
+	n, next := uc.pages.Next(scope, page, len(rs), func(i int) Cursor {
+		return Cursor{Time: rs[i].CreatedAt, ID: rs[i].RequesterID}
+	})
+	return rs[:n], next, nil
+}
+
+// ApproveFollowRequest turns the pending follow request of requesterID to
+// the authenticated user into a follow.
+func (uc *GraphUsecase) ApproveFollowRequest(ctx context.Context, requesterID int64) (*Follow, error) {
+	targetID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	uc.log.WithContext(ctx).Infof("ApproveFollowRequest: %d -> %d", requesterID, targetID)
+	var f *Follow
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		r, err := uc.requests.Find(ctx, requesterID, targetID)
+		if err != nil {
+			return err
+		}
+		if !r.ExpiresAt.After(time.Now()) {
+			return ErrFollowRequestNotFound
+		}
+		if err := uc.requests.Delete(ctx, requesterID, targetID); err != nil {
+			return err
+		}
+		f, err = uc.repo.Save(ctx, &Follow{FollowerID: requesterID, FolloweeID: targetID})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// RejectFollowRequest deletes the pending follow request of requesterID to
+// the authenticated user.
+func (uc *GraphUsecase) RejectFollowRequest(ctx context.Context, requesterID int64) error {
+	targetID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	uc.log.WithContext(ctx).Infof("RejectFollowRequest: %d -> %d", requesterID, targetID)
+	return uc.requests.Delete(ctx, requesterID, targetID)
+}
+
+// approveAllFollowRequests turns every pending follow request to targetID
+// into a follow, as when the account turns public. Callers run it in a
+// transaction.
+func (uc *GraphUsecase) approveAllFollowRequests(ctx context.Context, targetID int64) error {
+	rs, err := uc.requests.ListAll(ctx, targetID, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := uc.requests.DeleteAll(ctx, targetID); err != nil {
+		return err
+	}
+	for _, r := range rs {
+		_, err := uc.repo.Save(ctx, &Follow{FollowerID: r.RequesterID, FolloweeID: targetID})
+		if err != nil && !errors.Is(err, ErrAlreadyFollowing) {
+			return err
+		}
+	}
+	if len(rs) > 0 {
+		uc.log.WithContext(ctx).Infof("approved %d follow requests to %d", len(rs), targetID)
+	}
+	return nil
+}
+
+// PurgeExpiredFollowRequests deletes the expired follow requests.
+func (uc *GraphUsecase) PurgeExpiredFollowRequests(ctx context.Context) (int64, error) {
+	return uc.requests.DeleteExpired(ctx, time.Now())
+}
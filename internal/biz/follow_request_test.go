@@ -0,0 +1,160 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// private makes u a private account.
+func (a *testApp) private(t *testing.T, u *biz.User) {
+	t.Helper()
+	if _, err := a.user.UpdateUser(as(u), &biz.User{ID: u.ID, Private: true}, []string{biz.PathPrivate}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// requesters returns the requesters of the pending follow requests to u.
+func (a *testApp) requesters(t *testing.T, u *biz.User) []int64 {
+	t.Helper()
+	rs, _, err := a.graph.ListFollowRequests(as(u), biz.PageRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]int64, len(rs))
+	for i, r := range rs {
+		ids[i] = r.RequesterID
+	}
+	return ids
+}
+
+func TestApproveFollowRequest(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	joe := a.register(t, "joe", "joe@example.com", true)
+	a.private(t, jane)
+	ctx := context.Background()
+
+	if _, pending, err := a.graph.Follow(as(joe), jane.ID); err != nil || !pending {
+		t.Fatalf("Follow() of a private account = %t, %v, want pending", pending, err)
+	}
+	if _, _, err := a.graph.Follow(as(joe), jane.ID); !errors.Is(err, biz.ErrAlreadyRequested) {
+		t.Errorf("Follow() requested twice = %v, want ErrAlreadyRequested", err)
+	}
+	// Until approved, the request is no follow, and only jane sees it.
+	if ok, err := a.graph.IsFollowing(ctx, joe.ID, jane.ID); err != nil || ok {
+		t.Errorf("IsFollowing() while pending = %t, %v, want false", ok, err)
+	}
+	if fs, _, err := a.graph.ListFollowers(as(jane), jane.ID, biz.PageRequest{}); err != nil || len(fs) != 0 {
+		t.Errorf("ListFollowers() while pending = %d, %v, want none", len(fs), err)
+	}
+	if ids := a.requesters(t, joe); len(ids) != 0 {
+		t.Errorf("ListFollowRequests() of the requester = %v, want none", ids)
+	}
+	if ids := a.requesters(t, jane); len(ids) != 1 || ids[0] != joe.ID {
+		t.Errorf("ListFollowRequests() = %v, want [%d]", ids, joe.ID)
+	}
+
+	if _, err := a.graph.ApproveFollowRequest(as(joe), jane.ID); !errors.Is(err, biz.ErrFollowRequestNotFound) {
+		t.Errorf("ApproveFollowRequest() by the requester = %v, want ErrFollowRequestNotFound", err)
+	}
+	if f, err := a.graph.ApproveFollowRequest(as(jane), joe.ID); err != nil || f.FollowerID != joe.ID || f.FolloweeID != jane.ID {
+		t.Fatalf("ApproveFollowRequest() = %+v, %v, want joe following jane", f, err)
+	}
+	if ok, err := a.graph.IsFollowing(ctx, joe.ID, jane.ID); err != nil || !ok {
+		t.Errorf("IsFollowing() once approved = %t, %v, want true", ok, err)
+	}
+	if ids := a.requesters(t, jane); len(ids) != 0 {
+		t.Errorf("ListFollowRequests() once approved = %v, want none", ids)
+	}
+	if _, err := a.graph.ApproveFollowRequest(as(jane), joe.ID); !errors.Is(err, biz.ErrFollowRequestNotFound) {
+		t.Errorf("ApproveFollowRequest() twice = %v, want ErrFollowRequestNotFound", err)
+	}
+}
+
+func TestRejectFollowRequest(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	joe := a.register(t, "joe", "joe@example.com", true)
+	a.private(t, jane)
+	if _, _, err := a.graph.Follow(as(joe), jane.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.graph.RejectFollowRequest(as(jane), joe.ID); err != nil {
+		t.Fatalf("RejectFollowRequest() error = %v", err)
+	}
+	if ok, err := a.graph.IsFollowing(context.Background(), joe.ID, jane.ID); err != nil || ok {
+		t.Errorf("IsFollowing() once rejected = %t, %v, want false", ok, err)
+	}
+	if err := a.graph.RejectFollowRequest(as(jane), joe.ID); !errors.Is(err, biz.ErrFollowRequestNotFound) {
+		t.Errorf("RejectFollowRequest() twice = %v, want ErrFollowRequestNotFound", err)
+	}
+	if _, pending, err := a.graph.Follow(as(joe), jane.ID); err != nil || !pending {
+		t.Errorf("Follow() once rejected = %t, %v, want a new pending request", pending, err)
+	}
+}
+
+func TestFollowRequestExpired(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	joe := a.register(t, "joe", "joe@example.com", true)
+	ann := a.register(t, "ann", "ann@example.com", true)
+	a.private(t, jane)
+	ctx := context.Background()
+	for _, u := range []*biz.User{joe, ann} {
+		_, err := a.followRequests.Save(ctx, &biz.FollowRequest{RequesterID: u.ID, TargetID: jane.ID, ExpiresAt: time.Now().Add(-time.Second)})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if ids := a.requesters(t, jane); len(ids) != 0 {
+		t.Errorf("ListFollowRequests() of expired requests = %v, want none", ids)
+	}
+	if _, err := a.graph.ApproveFollowRequest(as(jane), joe.ID); !errors.Is(err, biz.ErrFollowRequestNotFound) {
+		t.Errorf("ApproveFollowRequest() of an expired request = %v, want ErrFollowRequestNotFound", err)
+	}
+	// A new request replaces the expired one.
+	if _, pending, err := a.graph.Follow(as(joe), jane.ID); err != nil || !pending {
+		t.Errorf("Follow() after an expired request = %t, %v, want pending", pending, err)
+	}
+	if n, err := a.graph.PurgeExpiredFollowRequests(ctx); err != nil || n != 1 {
+		t.Errorf("PurgeExpiredFollowRequests() = %d, %v, want the request of ann", n, err)
+	}
+	if ids := a.requesters(t, jane); len(ids) != 1 || ids[0] != joe.ID {
+		t.Errorf("ListFollowRequests() after the purge = %v, want [%d]", ids, joe.ID)
+	}
+}
+
+func TestPrivateAccountTurningPublic(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	joe := a.register(t, "joe", "joe@example.com", true)
+	ann := a.register(t, "ann", "ann@example.com", true)
+	a.private(t, jane)
+	for _, u := range []*biz.User{joe, ann} {
+		if _, _, err := a.graph.Follow(as(u), jane.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	u, err := a.user.UpdateUser(as(jane), &biz.User{ID: jane.ID}, []string{biz.PathPrivate})
+	if err != nil || u.Private {
+		t.Fatalf("UpdateUser() to public = %+v, %v", u, err)
+	}
+	for _, f := range []*biz.User{joe, ann} {
+		if ok, err := a.graph.IsFollowing(context.Background(), f.ID, jane.ID); err != nil || !ok {
+			t.Errorf("IsFollowing() of %s once public = %t, %v, want true", f.Username, ok, err)
+		}
+	}
+	if ids := a.requesters(t, jane); len(ids) != 0 {
+		t.Errorf("ListFollowRequests() once public = %v, want none", ids)
+	}
+	if _, pending, err := a.graph.Follow(as(a.register(t, "max", "max@example.com", true)), jane.ID); err != nil || pending {
+		t.Errorf("Follow() of a public account = %t, %v, want a follow", pending, err)
+	}
+}
@@ -0,0 +1,201 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "user/api/user/v1"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrCannotFollowSelf is a user following themselves.
+	ErrCannotFollowSelf = errors.BadRequest(v1.ErrorReason_CANNOT_FOLLOW_SELF.String(), "cannot follow yourself")
+	// ErrAlreadyFollowing is a follow that already exists.
+	ErrAlreadyFollowing = errors.Conflict(v1.ErrorReason_ALREADY_FOLLOWING.String(), "already following user")
+	// ErrNotFollowing is a follow that does not exist.
+	ErrNotFollowing = errors.NotFound(v1.ErrorReason_NOT_FOLLOWING.String(), "not following user")
+)
+
+// Follow is a directed edge of the social graph: FollowerID follows
+// FolloweeID.
+type Follow struct {
+	FollowerID int64
+	FolloweeID int64
+	CreatedAt  time.Time
+}
+
+// GraphRepo is a Follow repo.
+type GraphRepo interface {
+	// Save stores a follow, and returns ErrAlreadyFollowing if it exists.
+	Save(context.Context, *Follow) (*Follow, error)
+	// Delete removes a follow, and returns ErrNotFollowing if it does not
+	// exist.
+	Delete(ctx context.Context, followerID, followeeID int64) error
+	Exists(ctx context.Context, followerID, followeeID int64) (bool, error)
+	// ListFollowers returns a page of the follows of users following
+	// userID, newest first. The cursor ID is the follower.
+	ListFollowers(ctx context.Context, userID int64, page *Page) ([]*Follow, error)
+	// ListFollowing returns a page of the follows of users userID follows,
+	// newest first. The cursor ID is the followee.
+	ListFollowing(ctx context.Context, userID int64, page *Page) ([]*Follow, error)
+	// ReconcileCounts recomputes the follower and following counts of users
+	// that drifted from the follows, and returns how many it repaired.
+	ReconcileCounts(context.Context) (int64, error)
+}
+
+// GraphUsecase is a social graph usecase.
+type GraphUsecase struct {
+	repo     GraphRepo
+	requests FollowRequestRepo
+	blocks   BlockRepo
+	mutes    MuteRepo
+	users    UserRepo
+	tx       Transaction
+	pages    *Paginator
+	log      *log.Helper
+}
+
+// NewGraphUsecase new a social graph usecase.
+func NewGraphUsecase(repo GraphRepo, requests FollowRequestRepo, blocks BlockRepo, mutes MuteRepo, users UserRepo, tx Transaction, pages *Paginator, logger log.Logger) *GraphUsecase {
+	return &GraphUsecase{
+		repo:     repo,
+		requests: requests,
+		blocks:   blocks,
+		mutes:    mutes,
+		users:    users,
+		tx:       tx,
+		pages:    pages,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Follow makes the authenticated user follow followeeID, unless either
+// blocks the other. Following a private account only requests it, which
+// Follow reports as pending.
+func (uc *GraphUsecase) Follow(ctx context.Context, followeeID int64) (f *Follow, pending bool, err error) {
+	followerID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, false, ErrUnauthenticated
+	}
+	if followerID == followeeID {
+		return nil, false, ErrCannotFollowSelf
+	}
+	uc.log.WithContext(ctx).Infof("Follow: %d -> %d", followerID, followeeID)
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		followee, err := uc.users.FindByID(ctx, followeeID)
+		if err != nil {
+			return err
+		}
+		blocked, err := blockedBetween(ctx, uc.blocks, followerID, followeeID)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return ErrUserBlocked
+		}
+		if followee.Private {
+			r, err := uc.requestFollow(ctx, followerID, followeeID)
+			if err != nil {
+				return err
+			}
+			f, pending = &Follow{FollowerID: followerID, FolloweeID: followeeID, CreatedAt: r.CreatedAt}, true
+			return nil
+		}
+		f, err = uc.repo.Save(ctx, &Follow{FollowerID: followerID, FolloweeID: followeeID})
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return f, pending, nil
+}
+
+// Unfollow makes the authenticated user stop following followeeID, or
+// withdraws their pending follow request.
+func (uc *GraphUsecase) Unfollow(ctx context.Context, followeeID int64) error {
+	followerID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	uc.log.WithContext(ctx).Infof("Unfollow: %d -> %d", followerID, followeeID)
+	err := uc.repo.Delete(ctx, followerID, followeeID)
+	if !errors.Is(err, ErrNotFollowing) {
+		return err
+	}
+	if err := uc.requests.Delete(ctx, followerID, followeeID); errors.Is(err, ErrFollowRequestNotFound) {
+		return ErrNotFollowing
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsFollowing reports whether followerID follows followeeID.
+func (uc *GraphUsecase) IsFollowing(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	return uc.repo.Exists(ctx, followerID, followeeID)
+}
+
+// ReconcileCounts repairs the follower and following counts of every user.
+func (uc *GraphUsecase) ReconcileCounts(ctx context.Context) (int64, error) {
+	n, err := uc.repo.ReconcileCounts(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if n > 0 {
+		uc.log.WithContext(ctx).Warnf("ReconcileCounts: repaired the counts of %d users", n)
+	}
+	return n, nil
+}
+
+// ListFollowers lists a page of the follows of users following userID, and
+// returns the token of the next page. Users blocking the caller cannot be
+// listed.
+func (uc *GraphUsecase) ListFollowers(ctx context.Context, userID int64, req PageRequest) ([]*Follow, string, error) {
+	scope := fmt.Sprintf("followers:%d", userID)
+	page, err := uc.pages.Page(scope, req)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := uc.users.FindByID(ctx, userID); err != nil {
+		return nil, "", err
+	}
+	if err := checkViewable(ctx, uc.blocks, userID); err != nil {
+		return nil, "", err
+	}
+	fs, err := uc.repo.ListFollowers(ctx, userID, page)
+	if err != nil {
+		return nil, "", err
+	}
+	n, next := uc.pages.Next(scope, page, len(fs), func(i int) Cursor {
+		return Cursor{Time: fs[i].CreatedAt, ID: fs[i].FollowerID}
+	})
+	return fs[:n], next, nil
+}
+
+// ListFollowing lists a page of the follows of users userID follows, and
+// returns the token of the next page. Users blocking the caller cannot be
+// listed.
+func (uc *GraphUsecase) ListFollowing(ctx context.Context, userID int64, req PageRequest) ([]*Follow, string, error) {
+	scope := fmt.Sprintf("following:%d", userID)
+	page, err := uc.pages.Page(scope, req)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := uc.users.FindByID(ctx, userID); err != nil {
+		return nil, "", err
+	}
+	if err := checkViewable(ctx, uc.blocks, userID); err != nil {
+		return nil, "", err
+	}
+	fs, err := uc.repo.ListFollowing(ctx, userID, page)
+	if err != nil {
+		return nil, "", err
+	}
+	n, next := uc.pages.Next(scope, page, len(fs), func(i int) Cursor {
+		return Cursor{Time: fs[i].CreatedAt, ID: fs[i].FolloweeID}
+	})
+	return fs[:n], next, nil
+}
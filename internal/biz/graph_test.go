@@ -0,0 +1,65 @@
+package biz_test
+
+import (
+	"fmt"
+	"testing"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestListFollowersPages(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	joe := a.register(t, "joe", "joe@example.com", true)
+	want := make(map[int64]bool)
+	for i := 0; i < 5; i++ {
+		u := a.register(t, fmt.Sprintf("fan%d", i), fmt.Sprintf("fan%d@example.com", i), true)
+		if _, _, err := a.graph.Follow(as(u), jane.ID); err != nil {
+			t.Fatal(err)
+		}
+		want[u.ID] = true
+	}
+
+	var (
+		seen  = make(map[int64]bool)
+		req   = biz.PageRequest{Size: 2}
+		sizes []int
+		first string
+	)
+	for {
+		fs, next, err := a.graph.ListFollowers(as(joe), jane.ID, req)
+		if err != nil {
+			t.Fatalf("ListFollowers() error = %v", err)
+		}
+		sizes = append(sizes, len(fs))
+		for _, f := range fs {
+			if seen[f.FollowerID] || !want[f.FollowerID] {
+				t.Errorf("ListFollowers() listed %d again or unexpectedly", f.FollowerID)
+			}
+			seen[f.FollowerID] = true
+		}
+		if next == "" {
+			break
+		}
+		if first == "" {
+			first = next
+		}
+		req.Token = next
+	}
+	if fmt.Sprint(sizes) != "[2 2 1]" || len(seen) != len(want) {
+		t.Errorf("ListFollowers() pages of %v listed %d followers, want [2 2 1] listing %d", sizes, len(seen), len(want))
+	}
+
+	// The token of jane's followers is good for no other list.
+	req = biz.PageRequest{Token: first, Size: 2}
+	if _, _, err := a.graph.ListFollowing(as(joe), jane.ID, req); !errors.Is(err, biz.ErrPageTokenInvalid) {
+		t.Errorf("ListFollowing() with a followers token = %v, want ErrPageTokenInvalid", err)
+	}
+	if _, _, err := a.graph.ListFollowers(as(jane), joe.ID, req); !errors.Is(err, biz.ErrPageTokenInvalid) {
+		t.Errorf("ListFollowers() of another user with jane's token = %v, want ErrPageTokenInvalid", err)
+	}
+	if _, _, err := a.user.ListUsers(as(joe), req); !errors.Is(err, biz.ErrPageTokenInvalid) {
+		t.Errorf("ListUsers() with a followers token = %v, want ErrPageTokenInvalid", err)
+	}
+}
@@ -0,0 +1,158 @@
+package biz
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	v1 "user/api/user/v1"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/errors"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	minHandleLen = 3
+	maxHandleLen = 30
+
+	defaultHandleChangeInterval = 24 * time.Hour
+	defaultHandleGracePeriod    = 14 * 24 * time.Hour
+)
+
+var (
+	// ErrInvalidHandle is a handle breaking the handle syntax.
+	ErrInvalidHandle = errors.BadRequest(v1.ErrorReason_INVALID_HANDLE.String(),
+		"handle must be 3 to 30 letters, digits or underscores")
+	// ErrHandleReserved is a handle nobody may take.
+	ErrHandleReserved = errors.BadRequest(v1.ErrorReason_HANDLE_RESERVED.String(), "handle is reserved")
+	// ErrHandleChangeTooSoon is a handle change before the end of the
+	// change interval of the previous one.
+	ErrHandleChangeTooSoon = errors.New(http.StatusTooManyRequests, v1.ErrorReason_HANDLE_CHANGE_TOO_SOON.String(),
+		"handle was changed too recently")
+	// ErrHandleNotFound is a handle without a change in its grace period.
+	ErrHandleNotFound = errors.NotFound(v1.ErrorReason_HANDLE_NOT_FOUND.String(), "handle not found")
+)
+
+// HandleChange is a user giving up Handle. Until ExpiresAt the old handle
+// resolves to the user, and only they may take it again.
+type HandleChange struct {
+	UserID    int64
+	Handle    string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// HandleChangeRepo is a HandleChange repo, which keeps the changes past
+// their grace period as the handle history.
+type HandleChangeRepo interface {
+	Save(context.Context, *HandleChange) (*HandleChange, error)
+	// Find returns the latest change from the handle of the given key
+	// unexpired at at, or ErrHandleNotFound.
+	Find(ctx context.Context, key string, at time.Time) (*HandleChange, error)
+	// FindAll returns the latest change from the handle of each of the given
+	// keys unexpired at at, skipping the keys without one.
+	FindAll(ctx context.Context, keys []string, at time.Time) ([]*HandleChange, error)
+	// Last returns the latest change of userID, or ErrHandleNotFound.
+	Last(ctx context.Context, userID int64) (*HandleChange, error)
+}
+
+// Handles enforces the rules of user handles: their syntax, the reserved
+// handles, how often users change them and how long old ones are held.
+type Handles struct {
+	repo           HandleChangeRepo
+	reserved       map[string]bool
+	changeInterval time.Duration
+	gracePeriod    time.Duration
+}
+
+// NewHandles new a Handles from the handles config.
+func NewHandles(c *conf.Handles, repo HandleChangeRepo) *Handles {
+	h := &Handles{
+		repo:           repo,
+		reserved:       make(map[string]bool, len(c.GetReserved())),
+		changeInterval: defaultHandleChangeInterval,
+		gracePeriod:    defaultHandleGracePeriod,
+	}
+	for _, r := range c.GetReserved() {
+		h.reserved[HandleKey(r)] = true
+	}
+	if c.GetChangeInterval() != nil {
+		h.changeInterval = c.GetChangeInterval().AsDuration()
+	}
+	if c.GetRedirectGracePeriod() != nil {
+		h.gracePeriod = c.GetRedirectGracePeriod().AsDuration()
+	}
+	return h
+}
+
+// HandleKey returns the key handles are unique and looked up by: their NFKC
+// normalized case folding.
+func HandleKey(handle string) string {
+	return norm.NFKC.String(cases.Fold().String(norm.NFKC.String(strings.TrimSpace(handle))))
+}
+
+// normalize checks the syntax of a handle and that it is not reserved, and
+// returns its NFKC normalized form, which keeps its case.
+func (h *Handles) normalize(handle string) (string, error) {
+	handle = norm.NFKC.String(strings.TrimSpace(handle))
+	if n := utf8.RuneCountInString(handle); n < minHandleLen || n > maxHandleLen {
+		return "", ErrInvalidHandle
+	}
+	for i, r := range handle {
+		ok := unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || (i > 0 && unicode.IsMark(r))
+		if !ok {
+			return "", ErrInvalidHandle
+		}
+	}
+	if h.reserved[HandleKey(handle)] {
+		return "", ErrHandleReserved
+	}
+	return handle, nil
+}
+
+// checkAvailable returns ErrUsernameTaken if another user than userID gave
+// up the handle within its grace period. The unique key of the UserRepo
+// guards the current handles.
+func (h *Handles) checkAvailable(ctx context.Context, userID int64, handle string) error {
+	c, err := h.repo.Find(ctx, HandleKey(handle), time.Now())
+	if errors.Is(err, ErrHandleNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if c.UserID != userID {
+		return ErrUsernameTaken
+	}
+	return nil
+}
+
+// change records u giving up their handle for the normalized handle, and
+// holds the old one for the grace period. A change of case only is not
+// recorded nor limited. Callers run it in a transaction with the update.
+func (h *Handles) change(ctx context.Context, u *User, handle string) error {
+	if HandleKey(handle) == HandleKey(u.Username) {
+		return nil
+	}
+	last, err := h.repo.Last(ctx, u.ID)
+	switch {
+	case errors.Is(err, ErrHandleNotFound):
+	case err != nil:
+		return err
+	case time.Since(last.CreatedAt) < h.changeInterval:
+		return ErrHandleChangeTooSoon
+	}
+	if err := h.checkAvailable(ctx, u.ID, handle); err != nil {
+		return err
+	}
+	_, err = h.repo.Save(ctx, &HandleChange{
+		UserID:    u.ID,
+		Handle:    u.Username,
+		ExpiresAt: time.Now().Add(h.gracePeriod),
+	})
+	return err
+}
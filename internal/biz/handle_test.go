@@ -0,0 +1,78 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestHandleKey(t *testing.T) {
+	for _, pair := range [][2]string{
+		{"Jane", "jANE"},
+		{"Straße", "STRASSE"},
+		{"ｊａｎｅ", "jane"},
+		{"Ǆemal", "ǆemal"},
+	} {
+		if a, b := biz.HandleKey(pair[0]), biz.HandleKey(pair[1]); a != b {
+			t.Errorf("HandleKey(%q) = %q, HandleKey(%q) = %q, want equal", pair[0], a, pair[1], b)
+		}
+	}
+	if biz.HandleKey("jane") == biz.HandleKey("joe") {
+		t.Error("HandleKey() of distinct handles is equal")
+	}
+}
+
+func TestReservedHandles(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	for _, handle := range []string{"admin", "ADMIN", "support", "ｓｕｐｐｏｒｔ"} {
+		if _, err := a.user.ChangeHandle(as(jane), jane.ID, handle); !errors.Is(err, biz.ErrHandleReserved) {
+			t.Errorf("ChangeHandle(%q) = %v, want ErrHandleReserved", handle, err)
+		}
+	}
+	if _, err := a.auth.Register(context.Background(), &biz.User{Username: "Admin", Email: "admin@example.com"}, "password123"); !errors.Is(err, biz.ErrHandleReserved) {
+		t.Errorf("Register() with a reserved handle = %v, want ErrHandleReserved", err)
+	}
+}
+
+func TestChangeHandleRateLimit(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	if _, err := a.user.ChangeHandle(as(jane), jane.ID, "janet"); err != nil {
+		t.Fatalf("ChangeHandle() error = %v", err)
+	}
+	if _, err := a.user.ChangeHandle(as(jane), jane.ID, "janine"); !errors.Is(err, biz.ErrHandleChangeTooSoon) {
+		t.Errorf("second ChangeHandle() = %v, want ErrHandleChangeTooSoon", err)
+	}
+	u, err := a.user.ChangeHandle(as(jane), jane.ID, "Janet")
+	if err != nil || u.Username != "Janet" {
+		t.Errorf("ChangeHandle() of the case only = %+v, %v, want Janet", u, err)
+	}
+}
+
+func TestChangeHandleRedirect(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	joe := a.register(t, "joe", "joe@example.com", true)
+	if _, err := a.user.ChangeHandle(as(jane), jane.ID, "janet"); err != nil {
+		t.Fatalf("ChangeHandle() error = %v", err)
+	}
+
+	ctx := as(joe)
+	u, redirected, err := a.user.GetUserByHandle(ctx, "JANE")
+	if err != nil || u.ID != jane.ID || !redirected {
+		t.Errorf("GetUserByHandle() of the old handle = %v, %t, %v, want a redirect to %d", u, redirected, err, jane.ID)
+	}
+	u, redirected, err = a.user.GetUserByHandle(ctx, "janet")
+	if err != nil || u.ID != jane.ID || redirected {
+		t.Errorf("GetUserByHandle() of the new handle = %v, %t, %v, want %d", u, redirected, err, jane.ID)
+	}
+	if _, err := a.user.ChangeHandle(ctx, joe.ID, "Jane"); !errors.Is(err, biz.ErrUsernameTaken) {
+		t.Errorf("ChangeHandle() to a held handle = %v, want ErrUsernameTaken", err)
+	}
+	if _, _, err := a.user.GetUserByHandle(ctx, "nobody"); !errors.Is(err, biz.ErrUserNotFound) {
+		t.Errorf("GetUserByHandle() of an unknown handle = %v, want ErrUserNotFound", err)
+	}
+}
@@ -0,0 +1,626 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "user/api/user/v1"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	defaultAuthorizationCodeTTL = time.Minute
+
+	authorizationCodeBytes = 32
+	// maxStateLen bounds the state and nonce echoed back to clients.
+	maxStateLen = 512
+
+	// idTokenType is the typ header of ID tokens, which access tokens
+	// do not have.
+	idTokenType = "JWT"
+)
+
+// Scopes of OpenID Connect the server knows the claims of.
+const (
+	scopeOpenID  = "openid"
+	scopeProfile = "profile"
+	scopeEmail   = "email"
+)
+
+// Error codes of RFC 6749 and RFC 6750.
+const (
+	errInvalidRequest          = "invalid_request"
+	errInvalidClient           = "invalid_client"
+	errInvalidGrant            = "invalid_grant"
+	errUnauthorizedClient      = "unauthorized_client"
+	errUnsupportedGrantType    = "unsupported_grant_type"
+	errUnsupportedResponseType = "unsupported_response_type"
+	errInvalidScope            = "invalid_scope"
+	errServerError             = "server_error"
+)
+
+// ErrAuthorizationCodeNotFound is an authorization code hash that is not
+// stored.
+var ErrAuthorizationCodeNotFound = errors.NotFound(v1.ErrorReason_AUTHORIZATION_CODE_INVALID.String(), "authorization code not found")
+
+// OAuthError is an error of the OAuth2 endpoints, whose Code and
+// Description are sent to the client as of RFC 6749.
+type OAuthError struct {
+	Code        string
+	Description string
+}
+
+func oauthError(code, format string, args ...interface{}) *OAuthError {
+	return &OAuthError{Code: code, Description: fmt.Sprintf(format, args...)}
+}
+
+// InvalidOAuthRequest returns the error of a malformed request.
+func InvalidOAuthRequest(description string) *OAuthError {
+	return &OAuthError{Code: errInvalidRequest, Description: description}
+}
+
+func (e *OAuthError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+// Status returns the HTTP status of the error.
+func (e *OAuthError) Status() int {
+	switch e.Code {
+	case errInvalidClient:
+		return http.StatusUnauthorized
+	case errServerError:
+		return http.StatusInternalServerError
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// AuthorizationCode is a stored authorization code, exchanged once for the
+// tokens of a new session of its client. Only its hash is kept.
+type AuthorizationCode struct {
+	CodeHash string
+	ClientID string
+	UserID   int64
+	// RedirectURI is the redirect uri of the authorization request, empty
+	// if it named none.
+	RedirectURI string
+	Scope       string
+	Nonce       string
+	// CodeChallenge is the S256 PKCE challenge, empty without PKCE.
+	CodeChallenge string
+	CreatedAt     time.Time
+	ExpiresAt     time.Time
+	// UsedAt is set once the code has been exchanged for SessionID.
+	UsedAt    *time.Time
+	SessionID string
+}
+
+// AuthorizationCodeRepo is an AuthorizationCode repo.
+type AuthorizationCodeRepo interface {
+	// Save stores a code, and purges the expired ones.
+	Save(context.Context, *AuthorizationCode) error
+	FindByHash(context.Context, string) (*AuthorizationCode, error)
+	// Use marks an unused code as exchanged for a session, and reports
+	// whether it did.
+	Use(ctx context.Context, hash, sessionID string, at time.Time) (bool, error)
+}
+
+// AuthorizationRequest is a request of the authorization endpoint.
+type AuthorizationRequest struct {
+	ResponseType        string
+	ClientID            string
+	RedirectURI         string
+	Scope               string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+}
+
+// TokenRequest is a request of the token endpoint, with the credentials of
+// the client.
+type TokenRequest struct {
+	GrantType    string
+	ClientID     string
+	ClientSecret string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+	RefreshToken string
+	Scope        string
+}
+
+// TokenResponse is the reply of the token endpoint.
+type TokenResponse struct {
+	AccessToken *AccessToken
+	// RefreshToken is only handed to clients allowed the refresh_token
+	// grant.
+	RefreshToken string
+	// IDToken is set when the openid scope was granted.
+	IDToken string
+	Scope   string
+}
+
+// OAuthUsecase is the OAuth2 and OpenID Connect authorization server. The
+// user access tokens it issues are those of the service, bound to a session
+// of the client, and only call the API operations of the scopes they were
+// granted, as API keys do.
+type OAuthUsecase struct {
+	clients  OAuthClientRepo
+	codes    AuthorizationCodeRepo
+	users    UserRepo
+	auth     *AuthUsecase
+	sessions *Sessions
+	tx       Transaction
+	tokens   *TokenManager
+	pages    *Paginator
+	signer   *signer
+	issuer   string
+	loginURL string
+	codeTTL  time.Duration
+	// tokenTTL is the lifetime of ID tokens and client credentials tokens.
+	tokenTTL time.Duration
+	admins   map[int64]bool
+	log      *log.Helper
+}
+
+// NewOAuthUsecase new an OAuthUsecase.
+func NewOAuthUsecase(c *conf.OAuth, clients OAuthClientRepo, codes AuthorizationCodeRepo, users UserRepo, auth *AuthUsecase, sessions *Sessions, tx Transaction, tokens *TokenManager, pages *Paginator, logger log.Logger) (*OAuthUsecase, error) {
+	issuer, err := url.Parse(c.GetIssuer())
+	if err != nil || issuer.Scheme != "https" && issuer.Scheme != "http" || issuer.Host == "" || issuer.RawQuery != "" || issuer.Fragment != "" {
+		return nil, fmt.Errorf("biz: oauth.issuer must be an http or https URL, such as https://id.example.com")
+	}
+	s, err := newSigner(c, tokens, logger)
+	if err != nil {
+		return nil, err
+	}
+	uc := &OAuthUsecase{
+		clients:  clients,
+		codes:    codes,
+		users:    users,
+		auth:     auth,
+		sessions: sessions,
+		tx:       tx,
+		tokens:   tokens,
+		pages:    pages,
+		signer:   s,
+		issuer:   strings.TrimSuffix(c.GetIssuer(), "/"),
+		loginURL: c.GetLoginUrl(),
+		codeTTL:  defaultAuthorizationCodeTTL,
+		tokenTTL: tokens.ttl,
+		admins:   make(map[int64]bool),
+		log:      log.NewHelper(logger),
+	}
+	if d := c.GetAuthorizationCodeTtl(); d != nil {
+		uc.codeTTL = d.AsDuration()
+	}
+	if d := c.GetTokenTtl(); d != nil {
+		uc.tokenTTL = d.AsDuration()
+	}
+	for _, id := range c.GetAdminUserIds() {
+		uc.admins[id] = true
+	}
+	return uc, nil
+}
+
+// Issuer returns the issuer identifier of the server.
+func (uc *OAuthUsecase) Issuer() string {
+	return uc.issuer
+}
+
+// SigningAlgorithm returns the algorithm of the ID tokens.
+func (uc *OAuthUsecase) SigningAlgorithm() string {
+	return uc.signer.method.Alg()
+}
+
+// JWKS returns the public keys verifying the tokens of the server.
+func (uc *OAuthUsecase) JWKS() []JSONWebKey {
+	return uc.signer.jwks(uc.tokens)
+}
+
+// StartAuthorization checks an authorization request, and returns where to
+// send the user agent: the login page, passed query, or the redirect uri of
+// the client with the error of the request. Requests naming no valid
+// client and redirect uri fail with an OAuthError to show the user.
+func (uc *OAuthUsecase) StartAuthorization(ctx context.Context, r *AuthorizationRequest, query string) (string, error) {
+	redirectURI, err := uc.checkAuthorization(ctx, r)
+	if redirectURI == "" {
+		return "", err
+	}
+	if err != nil {
+		return redirectError(redirectURI, r.State, err)
+	}
+	if uc.loginURL == "" {
+		return "", oauthError(errServerError, "oauth.login_url is not configured")
+	}
+	sep := "?"
+	if strings.Contains(uc.loginURL, "?") {
+		sep = "&"
+	}
+	return uc.loginURL + sep + query, nil
+}
+
+// Authorize grants an authorization request on behalf of the user logged
+// in to the service, and returns the redirect uri of the client with the
+// authorization code, or with the error of the request.
+func (uc *OAuthUsecase) Authorize(ctx context.Context, r *AuthorizationRequest) (string, error) {
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return "", ErrUnauthenticated
+	}
+	if p.ClientID != "" {
+		return "", ErrPermissionDenied
+	}
+	redirectURI, err := uc.checkAuthorization(ctx, r)
+	if redirectURI == "" {
+		return "", err
+	}
+	if err != nil {
+		return redirectError(redirectURI, r.State, err)
+	}
+	code, err := randomToken(authorizationCodeBytes)
+	if err != nil {
+		return "", err
+	}
+	err = uc.codes.Save(ctx, &AuthorizationCode{
+		CodeHash:      hashToken(code),
+		ClientID:      r.ClientID,
+		UserID:        p.UserID,
+		RedirectURI:   r.RedirectURI,
+		Scope:         r.Scope,
+		Nonce:         r.Nonce,
+		CodeChallenge: r.CodeChallenge,
+		ExpiresAt:     time.Now().Add(uc.codeTTL),
+	})
+	if err != nil {
+		return "", err
+	}
+	uc.log.WithContext(ctx).Infof("Authorize: %d for client %s", p.UserID, r.ClientID)
+	q := url.Values{"code": {code}}
+	if r.State != "" {
+		q.Set("state", r.State)
+	}
+	return withQuery(redirectURI, q)
+}
+
+// checkAuthorization checks an authorization request, and normalizes its
+// scope. It returns the redirect uri to send errors to, which is empty if
+// the request names no valid client and redirect uri.
+func (uc *OAuthUsecase) checkAuthorization(ctx context.Context, r *AuthorizationRequest) (string, error) {
+	if r.ClientID == "" {
+		return "", oauthError(errInvalidRequest, "client_id is required")
+	}
+	c, err := uc.clients.FindByClientID(ctx, r.ClientID)
+	if errors.Is(err, ErrOAuthClientNotFound) {
+		return "", oauthError(errInvalidRequest, "unknown client_id")
+	}
+	if err != nil {
+		return "", err
+	}
+	redirectURI := r.RedirectURI
+	switch {
+	case redirectURI == "" && len(c.RedirectURIs) == 1:
+		redirectURI = c.RedirectURIs[0]
+	case redirectURI == "" || !contains(c.RedirectURIs, redirectURI):
+		return "", oauthError(errInvalidRequest, "redirect_uri is not registered for the client")
+	}
+	if len(r.State) > maxStateLen || len(r.Nonce) > maxStateLen {
+		return redirectURI, oauthError(errInvalidRequest, "state and nonce must be at most %d bytes", maxStateLen)
+	}
+	if r.ResponseType != "code" {
+		return redirectURI, oauthError(errUnsupportedResponseType, "response_type must be code")
+	}
+	if !c.allows(GrantAuthorizationCode) {
+		return redirectURI, oauthError(errUnauthorizedClient, "the client may not use the %s grant", GrantAuthorizationCode)
+	}
+	if r.Scope, err = grantScope(c, r.Scope); err != nil {
+		return redirectURI, err
+	}
+	switch {
+	case r.CodeChallenge == "" && c.Public():
+		return redirectURI, oauthError(errInvalidRequest, "public clients must use PKCE")
+	case r.CodeChallenge == "":
+	case r.CodeChallengeMethod != "S256":
+		return redirectURI, oauthError(errInvalidRequest, "code_challenge_method must be S256")
+	case len(r.CodeChallenge) != base64.RawURLEncoding.EncodedLen(sha256.Size):
+		return redirectURI, oauthError(errInvalidRequest, "code_challenge is invalid")
+	}
+	return redirectURI, nil
+}
+
+// Token serves a request of the token endpoint.
+func (uc *OAuthUsecase) Token(ctx context.Context, r *TokenRequest) (*TokenResponse, error) {
+	c, err := uc.authenticateClient(ctx, r.ClientID, r.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	switch r.GrantType {
+	case GrantAuthorizationCode, GrantRefreshToken, GrantClientCredentials:
+	case "":
+		return nil, oauthError(errInvalidRequest, "grant_type is required")
+	default:
+		return nil, oauthError(errUnsupportedGrantType, "unsupported grant_type %q", r.GrantType)
+	}
+	if !c.allows(r.GrantType) {
+		return nil, oauthError(errUnauthorizedClient, "the client may not use the %s grant", r.GrantType)
+	}
+	switch r.GrantType {
+	case GrantAuthorizationCode:
+		return uc.exchangeCode(ctx, c, r)
+	case GrantRefreshToken:
+		return uc.refresh(ctx, c, r)
+	default:
+		return uc.clientCredentials(c, r)
+	}
+}
+
+// authenticateClient returns the client of the credentials of a token
+// request. Public clients only identify themselves.
+func (uc *OAuthUsecase) authenticateClient(ctx context.Context, clientID, secret string) (*OAuthClient, error) {
+	if clientID == "" {
+		return nil, oauthError(errInvalidClient, "client authentication is required")
+	}
+	c, err := uc.clients.FindByClientID(ctx, clientID)
+	if errors.Is(err, ErrOAuthClientNotFound) {
+		return nil, oauthError(errInvalidClient, "client authentication failed")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if c.Public() && secret != "" || !c.Public() && !c.checkSecret(secret) {
+		return nil, oauthError(errInvalidClient, "client authentication failed")
+	}
+	return c, nil
+}
+
+// exchangeCode serves the authorization_code grant, opening a session of
+// the client. Presenting a code again means it leaked, so the session it
+// was exchanged for is revoked.
+func (uc *OAuthUsecase) exchangeCode(ctx context.Context, c *OAuthClient, r *TokenRequest) (*TokenResponse, error) {
+	if r.Code == "" {
+		return nil, oauthError(errInvalidRequest, "code is required")
+	}
+	hash := hashToken(r.Code)
+	var (
+		resp   *TokenResponse
+		reused *AuthorizationCode
+	)
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		code, err := uc.codes.FindByHash(ctx, hash)
+		if errors.Is(err, ErrAuthorizationCodeNotFound) {
+			return oauthError(errInvalidGrant, "authorization code is invalid")
+		}
+		if err != nil {
+			return err
+		}
+		if code.ClientID != c.ClientID {
+			return oauthError(errInvalidGrant, "authorization code is invalid")
+		}
+		if code.UsedAt != nil {
+			// Commit the revocation, the error is returned after the
+			// transaction.
+			reused = code
+			err := uc.sessions.revoke(ctx, code.UserID, code.SessionID)
+			if errors.Is(err, ErrSessionNotFound) {
+				return nil
+			}
+			return err
+		}
+		t := time.Now()
+		if !t.Before(code.ExpiresAt) {
+			return oauthError(errInvalidGrant, "authorization code has expired")
+		}
+		if r.RedirectURI != code.RedirectURI {
+			return oauthError(errInvalidGrant, "redirect_uri does not match the authorization request")
+		}
+		if !verifyCodeChallenge(code.CodeChallenge, r.CodeVerifier) {
+			return oauthError(errInvalidGrant, "code_verifier does not match the code challenge")
+		}
+		tokens, err := uc.auth.openSession(ctx, &Session{UserID: code.UserID, ClientID: c.ClientID, Scope: code.Scope})
+		if err != nil {
+			return err
+		}
+		ok, err := uc.codes.Use(ctx, hash, tokens.SessionID, t)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return oauthError(errInvalidGrant, "authorization code is invalid")
+		}
+		resp, err = uc.tokenResponse(ctx, c, code.UserID, code.Scope, code.Nonce, tokens)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reused != nil {
+		uc.sessions.forget(ctx, reused.SessionID)
+		uc.log.WithContext(ctx).Warnf("authorization code reuse detected, revoked session %s of user %d", reused.SessionID, reused.UserID)
+		return nil, oauthError(errInvalidGrant, "authorization code is invalid")
+	}
+	return resp, nil
+}
+
+// refresh serves the refresh_token grant, rotating the refresh token of a
+// session of the client. The scope of the session is kept, a narrower one
+// cannot be asked for.
+func (uc *OAuthUsecase) refresh(ctx context.Context, c *OAuthClient, r *TokenRequest) (*TokenResponse, error) {
+	if r.RefreshToken == "" {
+		return nil, oauthError(errInvalidRequest, "refresh_token is required")
+	}
+	tokens, s, err := uc.auth.rotate(ctx, r.RefreshToken, c.ClientID)
+	if errors.Is(err, ErrRefreshTokenInvalid) {
+		return nil, oauthError(errInvalidGrant, "refresh token is invalid")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return uc.tokenResponse(ctx, c, s.UserID, s.Scope, "", tokens)
+}
+
+// clientCredentials serves the client_credentials grant with an access
+// token of the client itself, which calls the operations of its scopes
+// that need no user, such as reading users. Having no session, it is only
+// void once expired, even if the client is deleted before.
+func (uc *OAuthUsecase) clientCredentials(c *OAuthClient, r *TokenRequest) (*TokenResponse, error) {
+	scope, err := grantScope(c, r.Scope)
+	if err != nil {
+		return nil, err
+	}
+	token, err := uc.tokens.issueClient(c.ClientID, scope, uc.tokenTTL)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenResponse{AccessToken: token, Scope: scope}, nil
+}
+
+// tokenResponse returns the tokens of a session of the client, with an ID
+// token if the openid scope was granted.
+func (uc *OAuthUsecase) tokenResponse(ctx context.Context, c *OAuthClient, userID int64, scope, nonce string, tokens *Tokens) (*TokenResponse, error) {
+	resp := &TokenResponse{AccessToken: tokens.AccessToken, Scope: scope}
+	if c.allows(GrantRefreshToken) {
+		resp.RefreshToken = tokens.RefreshToken
+	}
+	if !hasScope(scope, scopeOpenID) {
+		return resp, nil
+	}
+	u, err := uc.users.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	t := time.Now()
+	claims := userClaims(u, scope)
+	claims["iss"] = uc.issuer
+	claims["aud"] = c.ClientID
+	claims["azp"] = c.ClientID
+	claims["iat"] = t.Unix()
+	claims["exp"] = t.Add(uc.tokenTTL).Unix()
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+	if resp.IDToken, err = uc.signer.sign(idTokenType, claims); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UserInfo returns the claims about the user of an access token granted
+// the openid scope.
+func (uc *OAuthUsecase) UserInfo(ctx context.Context) (map[string]interface{}, error) {
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	if p.ClientCredentials() || !hasScope(p.Scope, scopeOpenID) {
+		return nil, ErrPermissionDenied
+	}
+	u, err := uc.users.FindByID(ctx, p.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return userClaims(u, p.Scope), nil
+}
+
+// userClaims returns the standard claims about u the scope grants.
+func userClaims(u *User, scope string) jwt.MapClaims {
+	claims := jwt.MapClaims{"sub": strconv.FormatInt(u.ID, 10)}
+	if hasScope(scope, scopeEmail) {
+		claims["email"] = u.Email
+		claims["email_verified"] = u.EmailVerified
+	}
+	if hasScope(scope, scopeProfile) {
+		p := &u.Profile
+		claims["preferred_username"] = u.Username
+		claims["updated_at"] = u.UpdatedAt.Unix()
+		for k, v := range map[string]string{
+			"name":     p.DisplayName,
+			"picture":  p.AvatarURL,
+			"website":  p.Website,
+			"locale":   p.Locale,
+			"zoneinfo": p.Timezone,
+		} {
+			if v != "" {
+				claims[k] = v
+			}
+		}
+		if !p.BirthDate.IsZero() {
+			claims["birthdate"] = p.BirthDate.Format("2006-01-02")
+		}
+	}
+	return claims
+}
+
+// grantScope returns the scopes of a request the client may be granted,
+// deduplicated.
+func grantScope(c *OAuthClient, scope string) (string, error) {
+	scopes := dedupe(strings.Fields(scope))
+	for _, s := range scopes {
+		if !contains(c.Scopes, s) {
+			return "", oauthError(errInvalidScope, "the client may not ask for the %s scope", s)
+		}
+	}
+	return strings.Join(scopes, " "), nil
+}
+
+// hasScope reports whether a space separated list of scopes holds s.
+func hasScope(scope, s string) bool {
+	return contains(strings.Fields(scope), s)
+}
+
+// verifyCodeChallenge checks the PKCE verifier of an S256 challenge. Codes
+// issued without a challenge take no verifier.
+func verifyCodeChallenge(challenge, verifier string) bool {
+	if challenge == "" {
+		return verifier == ""
+	}
+	if len(verifier) < 43 || len(verifier) > 128 || strings.Trim(verifier, pkceAlphabet) != "" {
+		return false
+	}
+	return codeChallenge(verifier) == challenge
+}
+
+// codeChallenge returns the S256 PKCE challenge of a verifier.
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// pkceAlphabet is the alphabet of PKCE code verifiers.
+const pkceAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-._~"
+
+// redirectError returns the redirect uri with an error.
+func redirectError(redirectURI, state string, err error) (string, error) {
+	var oe *OAuthError
+	if !errors.As(err, &oe) {
+		return "", err
+	}
+	q := url.Values{"error": {oe.Code}, "error_description": {oe.Description}}
+	if state != "" {
+		q.Set("state", state)
+	}
+	return withQuery(redirectURI, q)
+}
+
+// withQuery adds the parameters of q to the query of a URL.
+func withQuery(rawURL string, q url.Values) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	uq := u.Query()
+	for k, v := range q {
+		uq[k] = v
+	}
+	u.RawQuery = uq.Encode()
+	return u.String(), nil
+}
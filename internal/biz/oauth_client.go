@@ -0,0 +1,241 @@
+package biz
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	v1 "user/api/user/v1"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Grant types of the token endpoint.
+const (
+	GrantAuthorizationCode = "authorization_code"
+	GrantRefreshToken      = "refresh_token"
+	GrantClientCredentials = "client_credentials"
+)
+
+const (
+	clientIDBytes     = 16
+	clientSecretBytes = 32
+)
+
+var (
+	defaultClientGrantTypes = []string{GrantAuthorizationCode, GrantRefreshToken}
+	defaultClientScopes     = []string{scopeOpenID, scopeProfile, scopeEmail}
+)
+
+// ErrOAuthClientNotFound is oauth client not found.
+var ErrOAuthClientNotFound = errors.NotFound(v1.ErrorReason_OAUTH_CLIENT_NOT_FOUND.String(), "oauth client not found")
+
+// OAuthClient is an application registered with the OAuth2 authorization
+// server. Only the hash of its secret is kept.
+type OAuthClient struct {
+	ID       int64
+	ClientID string
+	// SecretHash is empty for public clients, which cannot keep a secret
+	// and must use PKCE.
+	SecretHash   string
+	Name         string
+	RedirectURIs []string
+	GrantTypes   []string
+	Scopes       []string
+	CreatedAt    time.Time
+}
+
+// Public reports whether the client has no secret.
+func (c *OAuthClient) Public() bool {
+	return c.SecretHash == ""
+}
+
+// allows reports whether the client may use a grant type.
+func (c *OAuthClient) allows(grant string) bool {
+	return contains(c.GrantTypes, grant)
+}
+
+// checkSecret reports whether secret is the secret of a confidential
+// client.
+func (c *OAuthClient) checkSecret(secret string) bool {
+	if c.Public() {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(hashToken(secret)), []byte(c.SecretHash)) == 1
+}
+
+// OAuthClientRepo is an OAuthClient repo.
+type OAuthClientRepo interface {
+	Save(context.Context, *OAuthClient) (*OAuthClient, error)
+	FindByClientID(context.Context, string) (*OAuthClient, error)
+	// List returns a page of clients ordered by id.
+	List(context.Context, *Page) ([]*OAuthClient, error)
+	Delete(ctx context.Context, clientID string) error
+}
+
+// invalidOAuthClient is the error of a client registration breaking a
+// rule.
+func invalidOAuthClient(format string, args ...interface{}) error {
+	return errors.BadRequest(v1.ErrorReason_INVALID_OAUTH_CLIENT.String(), fmt.Sprintf(format, args...))
+}
+
+// normalize defaults the grant types and scopes of a new client, and
+// checks it.
+func (c *OAuthClient) normalize(public bool) error {
+	c.Name = strings.TrimSpace(c.Name)
+	if len(c.GrantTypes) == 0 {
+		c.GrantTypes = defaultClientGrantTypes
+	}
+	if len(c.Scopes) == 0 {
+		c.Scopes = defaultClientScopes
+	}
+	c.GrantTypes = dedupe(c.GrantTypes)
+	c.Scopes = dedupe(c.Scopes)
+	c.RedirectURIs = dedupe(c.RedirectURIs)
+	if c.Name == "" {
+		return invalidOAuthClient("name is required")
+	}
+	for _, g := range c.GrantTypes {
+		switch g {
+		case GrantAuthorizationCode, GrantRefreshToken:
+		case GrantClientCredentials:
+			if public {
+				return invalidOAuthClient("public clients cannot use the %s grant", g)
+			}
+		default:
+			return invalidOAuthClient("unknown grant type %q", g)
+		}
+	}
+	if c.allows(GrantRefreshToken) && !c.allows(GrantAuthorizationCode) {
+		return invalidOAuthClient("the %s grant needs the %s grant", GrantRefreshToken, GrantAuthorizationCode)
+	}
+	if c.allows(GrantAuthorizationCode) && len(c.RedirectURIs) == 0 {
+		return invalidOAuthClient("the %s grant needs a redirect uri", GrantAuthorizationCode)
+	}
+	for _, s := range c.Scopes {
+		if strings.ContainsAny(s, " \"\\") {
+			return invalidOAuthClient("invalid scope %q", s)
+		}
+	}
+	for _, raw := range c.RedirectURIs {
+		u, err := url.Parse(raw)
+		if err != nil || !u.IsAbs() || u.Fragment != "" || strings.ContainsAny(raw, " \t\r\n") {
+			return invalidOAuthClient("invalid redirect uri %q", raw)
+		}
+	}
+	return nil
+}
+
+// CreateClient registers a client, and returns it with its secret, empty
+// for public clients.
+func (uc *OAuthUsecase) CreateClient(ctx context.Context, c *OAuthClient, public bool) (*OAuthClient, string, error) {
+	if err := uc.authorizeAdmin(ctx); err != nil {
+		return nil, "", err
+	}
+	if err := c.normalize(public); err != nil {
+		return nil, "", err
+	}
+	id, err := randomToken(clientIDBytes)
+	if err != nil {
+		return nil, "", err
+	}
+	c.ClientID = id
+	var secret string
+	if !public {
+		if secret, err = randomToken(clientSecretBytes); err != nil {
+			return nil, "", err
+		}
+		c.SecretHash = hashToken(secret)
+	}
+	nc, err := uc.clients.Save(ctx, c)
+	if err != nil {
+		return nil, "", err
+	}
+	uc.log.WithContext(ctx).Infof("CreateClient: %s %q", nc.ClientID, nc.Name)
+	return nc, secret, nil
+}
+
+// GetClient returns a client.
+func (uc *OAuthUsecase) GetClient(ctx context.Context, clientID string) (*OAuthClient, error) {
+	if err := uc.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return uc.clients.FindByClientID(ctx, clientID)
+}
+
+// ListClients returns a page of clients, the oldest first.
+func (uc *OAuthUsecase) ListClients(ctx context.Context, req PageRequest) ([]*OAuthClient, string, error) {
+	if err := uc.authorizeAdmin(ctx); err != nil {
+		return nil, "", err
+	}
+	const scope = "oauth_clients"
+	page, err := uc.pages.Page(scope, req)
+	if err != nil {
+		return nil, "", err
+	}
+	cs, err := uc.clients.List(ctx, page)
+	if err != nil {
+		return nil, "", err
+	}
+	n, next := uc.pages.Next(scope, page, len(cs), func(i int) Cursor { return Cursor{ID: cs[i].ID} })
+	return cs[:n], next, nil
+}
+
+// DeleteClient deletes a client, with its pending authorization codes, and
+// revokes the sessions it holds.
+func (uc *OAuthUsecase) DeleteClient(ctx context.Context, clientID string) error {
+	if err := uc.authorizeAdmin(ctx); err != nil {
+		return err
+	}
+	var ids []string
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.clients.Delete(ctx, clientID); err != nil {
+			return err
+		}
+		var err error
+		ids, err = uc.sessions.revokeClient(ctx, clientID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	uc.sessions.forget(ctx, ids...)
+	uc.log.WithContext(ctx).Infof("DeleteClient: %s revoked %d sessions", clientID, len(ids))
+	return nil
+}
+
+// authorizeAdmin checks that the caller is an administrator logged in to
+// the service itself.
+func (uc *OAuthUsecase) authorizeAdmin(ctx context.Context) error {
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	if !uc.admins[p.UserID] || p.ClientID != "" {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// contains reports whether ss holds s.
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// dedupe returns ss without its empty and repeated strings, in order.
+func dedupe(ss []string) []string {
+	out := make([]string, 0, len(ss))
+	for _, s := range ss {
+		if s != "" && !contains(out, s) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,126 @@
+package biz
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"os"
+
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// JSONWebKey is a public key of a JWK Set, as of RFC 7517.
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid,omitempty"`
+	// N and E are the modulus and exponent of RSA keys.
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Crv and X are the curve and public key of Ed25519 keys.
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// signer signs the ID tokens and the tokens of OAuth clients.
+type signer struct {
+	method jwt.SigningMethod
+	key    crypto.Signer
+	keyID  string
+}
+
+// newSigner loads the signing key of the oauth config, or falls back to the
+// access token key if asymmetric, else makes one.
+func newSigner(c *conf.OAuth, tokens *TokenManager, logger log.Logger) (*signer, error) {
+	s := &signer{keyID: c.GetKeyId()}
+	switch key, ok := tokens.signKey.(crypto.Signer); {
+	case c.GetSigningKeyFile() != "":
+		pem, err := os.ReadFile(c.GetSigningKeyFile())
+		if err != nil {
+			return nil, fmt.Errorf("biz: read oauth.signing_key_file: %w", err)
+		}
+		if k, err := jwt.ParseRSAPrivateKeyFromPEM(pem); err == nil {
+			s.method, s.key = jwt.SigningMethodRS256, k
+		} else if k, err := jwt.ParseEdPrivateKeyFromPEM(pem); err == nil {
+			s.method, s.key = jwt.SigningMethodEdDSA, k.(crypto.Signer)
+		} else {
+			return nil, fmt.Errorf("biz: parse oauth.signing_key_file: not an RSA nor Ed25519 private key")
+		}
+	case ok:
+		s.method, s.key = tokens.method, key
+		if s.keyID == "" {
+			s.keyID = tokens.keyID
+		}
+	default:
+		log.NewHelper(logger).Warn("oauth.signing_key_file is empty and auth.jwt is symmetric, using a random key: ID tokens do not survive restarts nor work across replicas")
+		k, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return nil, err
+		}
+		s.method, s.key = jwt.SigningMethodRS256, k
+	}
+	if s.keyID == "" {
+		id, err := thumbprint(s.key.Public())
+		if err != nil {
+			return nil, err
+		}
+		s.keyID = id
+	}
+	return s, nil
+}
+
+// sign signs claims as a token of type typ.
+func (s *signer) sign(typ string, claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(s.method, claims)
+	token.Header["typ"] = typ
+	token.Header["kid"] = s.keyID
+	return token.SignedString(s.key)
+}
+
+// jwks returns the public keys verifying the tokens of the server: the
+// signing key and, if asymmetric and another one, the access token key.
+func (s *signer) jwks(tokens *TokenManager) []JSONWebKey {
+	keys := []JSONWebKey{jwkOf(s.key.Public(), s.method.Alg(), s.keyID)}
+	if tokens.method == jwt.SigningMethodHS256 {
+		return keys
+	}
+	if pub, ok := tokens.verifyKey.(interface{ Equal(crypto.PublicKey) bool }); ok && !pub.Equal(s.key.Public()) {
+		keys = append(keys, jwkOf(pub, tokens.method.Alg(), tokens.keyID))
+	}
+	return keys
+}
+
+// jwkOf returns the JWK of an RSA or Ed25519 public key.
+func jwkOf(pub crypto.PublicKey, alg, kid string) JSONWebKey {
+	k := JSONWebKey{Use: "sig", Alg: alg, Kid: kid}
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		k.Kty = "RSA"
+		k.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		k.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		k.Kty = "OKP"
+		k.Crv = "Ed25519"
+		k.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+	return k
+}
+
+// thumbprint identifies a public key by the hash of its DER encoding.
+func thumbprint(pub crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(der)
+	return base64.RawURLEncoding.EncodeToString(sum[:12]), nil
+}
@@ -0,0 +1,194 @@
+package biz_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"testing"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	testRedirectURI = "https://app.example.com/callback"
+	testVerifier    = "dBjftJeZ4CVP-mJ92ZUhzPjzobs2RuHk2NBnP4RgLRU"
+)
+
+// asAdmin returns a context authenticated as the OAuth administrator.
+func asAdmin() context.Context {
+	return biz.NewPrincipalContext(context.Background(), &biz.Principal{UserID: testAdminID, SessionID: "test"})
+}
+
+// createClient registers a client allowed grants, and returns it with its
+// secret, empty if public.
+func (a *testApp) createClient(t *testing.T, public bool, grants ...string) (*biz.OAuthClient, string) {
+	t.Helper()
+	c, secret, err := a.oauth.CreateClient(asAdmin(), &biz.OAuthClient{
+		Name:         "app",
+		RedirectURIs: []string{testRedirectURI},
+		GrantTypes:   grants,
+		Scopes:       []string{"openid", biz.ScopeUsersRead},
+	}, public)
+	if err != nil {
+		t.Fatalf("CreateClient() error = %v", err)
+	}
+	return c, secret
+}
+
+// authorize has u grant the request r, and returns the authorization code.
+func (a *testApp) authorize(t *testing.T, u *biz.User, r *biz.AuthorizationRequest) string {
+	t.Helper()
+	r.ResponseType = "code"
+	r.RedirectURI = testRedirectURI
+	redirect, err := a.oauth.Authorize(as(u), r)
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+	q, err := url.Parse(redirect)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := q.Query().Get("code")
+	if code == "" {
+		t.Fatalf("Authorize() redirected to %s, want a code", redirect)
+	}
+	return code
+}
+
+// s256 returns the S256 PKCE challenge of verifier.
+func s256(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// oauthCode returns the OAuth error code of err, or "" if it is none.
+func oauthCode(err error) string {
+	var oe *biz.OAuthError
+	if errors.As(err, &oe) {
+		return oe.Code
+	}
+	return ""
+}
+
+func TestAuthorizePKCE(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	c, _ := a.createClient(t, true)
+
+	// Public clients are redirected with the error of a missing or plain
+	// challenge.
+	for _, r := range []*biz.AuthorizationRequest{
+		{ClientID: c.ClientID},
+		{ClientID: c.ClientID, CodeChallenge: testVerifier, CodeChallengeMethod: "plain"},
+	} {
+		r.ResponseType = "code"
+		r.RedirectURI = testRedirectURI
+		redirect, err := a.oauth.Authorize(as(jane), r)
+		if err != nil || !strings.Contains(redirect, "error=invalid_request") {
+			t.Errorf("Authorize() with challenge %q by %q = %s, %v, want an invalid_request redirect", r.CodeChallenge, r.CodeChallengeMethod, redirect, err)
+		}
+	}
+
+	ctx := context.Background()
+	code := a.authorize(t, jane, &biz.AuthorizationRequest{ClientID: c.ClientID, CodeChallenge: s256(testVerifier), CodeChallengeMethod: "S256"})
+	req := &biz.TokenRequest{GrantType: biz.GrantAuthorizationCode, ClientID: c.ClientID, Code: code, RedirectURI: testRedirectURI}
+	for _, verifier := range []string{"", strings.Repeat("a", 43), testVerifier + "x"} {
+		req.CodeVerifier = verifier
+		if _, err := a.oauth.Token(ctx, req); oauthCode(err) != "invalid_grant" {
+			t.Errorf("Token() with verifier %q = %v, want invalid_grant", verifier, err)
+		}
+	}
+	req.CodeVerifier = testVerifier
+	resp, err := a.oauth.Token(ctx, req)
+	if err != nil {
+		t.Fatalf("Token() with the verifier = %v", err)
+	}
+	if resp.AccessToken == nil || resp.RefreshToken == "" || resp.IDToken != "" {
+		t.Errorf("Token() = %+v, want an access and a refresh token", resp)
+	}
+}
+
+func TestAuthorizationCodeReuse(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	c, secret := a.createClient(t, false)
+	ctx := context.Background()
+	code := a.authorize(t, jane, &biz.AuthorizationRequest{ClientID: c.ClientID, Scope: "openid"})
+	req := &biz.TokenRequest{GrantType: biz.GrantAuthorizationCode, ClientID: c.ClientID, ClientSecret: secret, Code: code, RedirectURI: testRedirectURI}
+	resp, err := a.oauth.Token(ctx, req)
+	if err != nil {
+		t.Fatalf("Token() error = %v", err)
+	}
+	if resp.IDToken == "" {
+		t.Error("Token() for the openid scope returned no ID token")
+	}
+	p, err := a.tokens.Verify(resp.AccessToken.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Presenting the code again revokes the session it was exchanged for.
+	if _, err := a.oauth.Token(ctx, req); oauthCode(err) != "invalid_grant" {
+		t.Fatalf("Token() with a used code = %v, want invalid_grant", err)
+	}
+	if err := a.sessions.Check(ctx, p); !errors.Is(err, biz.ErrSessionRevoked) {
+		t.Errorf("Check() of the session of a reused code = %v, want ErrSessionRevoked", err)
+	}
+	refresh := &biz.TokenRequest{GrantType: biz.GrantRefreshToken, ClientID: c.ClientID, ClientSecret: secret, RefreshToken: resp.RefreshToken}
+	if _, err := a.oauth.Token(ctx, refresh); oauthCode(err) != "invalid_grant" {
+		t.Errorf("Token() refreshing the session of a reused code = %v, want invalid_grant", err)
+	}
+}
+
+func TestRefreshTokenOfOtherClient(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	c, secret := a.createClient(t, false)
+	other, otherSecret := a.createClient(t, false)
+	ctx := context.Background()
+	code := a.authorize(t, jane, &biz.AuthorizationRequest{ClientID: c.ClientID})
+	resp, err := a.oauth.Token(ctx, &biz.TokenRequest{GrantType: biz.GrantAuthorizationCode, ClientID: c.ClientID, ClientSecret: secret, Code: code, RedirectURI: testRedirectURI})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := a.oauth.Token(ctx, &biz.TokenRequest{GrantType: biz.GrantRefreshToken, ClientID: other.ClientID, ClientSecret: otherSecret, RefreshToken: resp.RefreshToken}); oauthCode(err) != "invalid_grant" {
+		t.Errorf("Token() refreshing the session of another client = %v, want invalid_grant", err)
+	}
+	if _, err := a.auth.RefreshToken(ctx, resp.RefreshToken); !errors.Is(err, biz.ErrRefreshTokenInvalid) {
+		t.Errorf("RefreshToken() of a client session = %v, want ErrRefreshTokenInvalid", err)
+	}
+	// Neither rotated the token.
+	if _, err := a.oauth.Token(ctx, &biz.TokenRequest{GrantType: biz.GrantRefreshToken, ClientID: c.ClientID, ClientSecret: secret, RefreshToken: resp.RefreshToken}); err != nil {
+		t.Errorf("Token() refreshing the session of the client = %v", err)
+	}
+}
+
+func TestClientCredentials(t *testing.T) {
+	a := newTestApp(t, nil)
+	c, secret := a.createClient(t, false, biz.GrantClientCredentials)
+	ctx := context.Background()
+	resp, err := a.oauth.Token(ctx, &biz.TokenRequest{GrantType: biz.GrantClientCredentials, ClientID: c.ClientID, ClientSecret: secret, Scope: biz.ScopeUsersRead})
+	if err != nil {
+		t.Fatalf("Token() error = %v", err)
+	}
+	if resp.RefreshToken != "" || resp.IDToken != "" {
+		t.Errorf("Token() = %+v, want only an access token", resp)
+	}
+	p, err := a.tokens.Verify(resp.AccessToken.Token)
+	if err != nil {
+		t.Fatalf("Verify() of the token = %v", err)
+	}
+	if !p.ClientCredentials() || p.ClientID != c.ClientID || p.Scope != biz.ScopeUsersRead {
+		t.Errorf("Verify() = %+v, want the client with scope %s", p, biz.ScopeUsersRead)
+	}
+	if _, ok := biz.UserIDFromContext(biz.NewPrincipalContext(ctx, p)); ok {
+		t.Error("UserIDFromContext() of a client token found a user")
+	}
+	if _, err := a.oauth.Token(ctx, &biz.TokenRequest{GrantType: biz.GrantClientCredentials, ClientID: c.ClientID, ClientSecret: "wrong"}); oauthCode(err) != "invalid_client" {
+		t.Errorf("Token() with a wrong secret = %v, want invalid_client", err)
+	}
+}
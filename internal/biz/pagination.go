@@ -0,0 +1,169 @@
+package biz
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	v1 "user/api/user/v1"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+var (
+	// ErrPageTokenInvalid is a page token that was not issued for the list,
+	// or was altered.
+	ErrPageTokenInvalid = errors.BadRequest(v1.ErrorReason_PAGE_TOKEN_INVALID.String(), "page token is invalid")
+	// ErrPageSizeInvalid is a negative page size.
+	ErrPageSizeInvalid = errors.BadRequest(v1.ErrorReason_PAGE_SIZE_INVALID.String(), "page size must not be negative")
+)
+
+// PageRequest is the page of a list asked for by a client.
+type PageRequest struct {
+	// Token is the next page token of the previous page, empty for the
+	// first page.
+	Token string
+	// Size is the number of items asked for, 0 for the default.
+	Size int
+}
+
+// Cursor is a position in the ordering of a list: the sort key of the last
+// item of a page. Lists ordered by id only leave Time zero.
+type Cursor struct {
+	Time time.Time
+	ID   int64
+}
+
+// Page is the page of a list to read from a repo.
+type Page struct {
+	// After is the position to resume after, nil for the first page.
+	After *Cursor
+	// Size is the number of items of the page. Repos return up to Size+1
+	// items, so that the extra one tells whether a next page exists.
+	Size int
+}
+
+// pageToken is the signed content of a page token. Scope binds the token to
+// the list it was issued for.
+type pageToken struct {
+	Scope string `json:"s"`
+	Time  int64  `json:"t,omitempty"`
+	ID    int64  `json:"i"`
+}
+
+// Paginator issues and checks the opaque page tokens of list operations.
+type Paginator struct {
+	key         []byte
+	defaultSize int
+	maxSize     int
+}
+
+// NewPaginator new a Paginator from the pagination config.
+func NewPaginator(c *conf.Pagination, logger log.Logger) (*Paginator, error) {
+	p := &Paginator{
+		key:         []byte(c.GetTokenSecret()),
+		defaultSize: defaultPageSize,
+		maxSize:     maxPageSize,
+	}
+	if c.GetMaxPageSize() > 0 {
+		p.maxSize = int(c.GetMaxPageSize())
+	}
+	if c.GetDefaultPageSize() > 0 {
+		p.defaultSize = int(c.GetDefaultPageSize())
+	}
+	if p.defaultSize > p.maxSize {
+		p.defaultSize = p.maxSize
+	}
+	if len(p.key) == 0 {
+		log.NewHelper(logger).Warn("pagination.token_secret is not set, page tokens will not survive a restart")
+		p.key = make([]byte, 32)
+		if _, err := rand.Read(p.key); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Page checks a page request of the list identified by scope, and returns
+// the page to read.
+func (p *Paginator) Page(scope string, req PageRequest) (*Page, error) {
+	page := &Page{Size: req.Size}
+	switch {
+	case req.Size < 0:
+		return nil, ErrPageSizeInvalid
+	case req.Size == 0:
+		page.Size = p.defaultSize
+	case req.Size > p.maxSize:
+		page.Size = p.maxSize
+	}
+	if req.Token == "" {
+		return page, nil
+	}
+	t, err := p.parse(req.Token)
+	if err != nil || t.Scope != scope {
+		return nil, ErrPageTokenInvalid
+	}
+	page.After = &Cursor{ID: t.ID}
+	if t.Time != 0 {
+		page.After.Time = time.Unix(0, t.Time).UTC()
+	}
+	return page, nil
+}
+
+// Next trims the items a repo returned for page to n, the page size, and
+// returns the token of the next page, or "" on the last page. cursor gives
+// the position of an item.
+func (p *Paginator) Next(scope string, page *Page, n int, cursor func(i int) Cursor) (int, string) {
+	if n <= page.Size {
+		return n, ""
+	}
+	c := cursor(page.Size - 1)
+	t := pageToken{Scope: scope, ID: c.ID}
+	if !c.Time.IsZero() {
+		t.Time = c.Time.UnixNano()
+	}
+	return page.Size, p.sign(t)
+}
+
+// sign encodes t as base64(json).base64(hmac).
+func (p *Paginator) sign(t pageToken) string {
+	b, _ := json.Marshal(t)
+	payload := base64.RawURLEncoding.EncodeToString(b)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(p.mac(payload))
+}
+
+func (p *Paginator) parse(token string) (*pageToken, error) {
+	i := strings.IndexByte(token, '.')
+	if i < 0 {
+		return nil, ErrPageTokenInvalid
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(token[i+1:])
+	if err != nil || !hmac.Equal(sig, p.mac(token[:i])) {
+		return nil, ErrPageTokenInvalid
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token[:i])
+	if err != nil {
+		return nil, ErrPageTokenInvalid
+	}
+	var t pageToken
+	if err := json.Unmarshal(b, &t); err != nil {
+		return nil, ErrPageTokenInvalid
+	}
+	return &t, nil
+}
+
+func (p *Paginator) mac(payload string) []byte {
+	h := hmac.New(sha256.New, p.key)
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
@@ -0,0 +1,92 @@
+package biz
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func newTestPaginator(t *testing.T, c *conf.Pagination) *Paginator {
+	t.Helper()
+	p, err := NewPaginator(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// nextToken returns the token Next gives after a page of size items whose
+// last one is at c.
+func nextToken(p *Paginator, scope string, size int, c Cursor) string {
+	_, token := p.Next(scope, &Page{Size: size}, size+1, func(int) Cursor { return c })
+	return token
+}
+
+func TestPaginatorPageSize(t *testing.T) {
+	p := newTestPaginator(t, &conf.Pagination{TokenSecret: "secret", DefaultPageSize: 20, MaxPageSize: 100})
+	for size, want := range map[int]int{0: 20, 1: 1, 100: 100, 101: 100, 1 << 30: 100} {
+		if page, err := p.Page("users", PageRequest{Size: size}); err != nil || page.Size != want || page.After != nil {
+			t.Errorf("Page() of size %d = %+v, %v, want a first page of %d", size, page, err, want)
+		}
+	}
+	if _, err := p.Page("users", PageRequest{Size: -1}); err != ErrPageSizeInvalid {
+		t.Errorf("Page() of a negative size = %v, want ErrPageSizeInvalid", err)
+	}
+
+	// The default is capped by the maximum.
+	p = newTestPaginator(t, &conf.Pagination{TokenSecret: "secret", DefaultPageSize: 200, MaxPageSize: 100})
+	if page, err := p.Page("users", PageRequest{}); err != nil || page.Size != 100 {
+		t.Errorf("Page() with a default over the maximum = %+v, %v, want 100", page, err)
+	}
+}
+
+func TestPaginatorNext(t *testing.T) {
+	p := newTestPaginator(t, &conf.Pagination{TokenSecret: "secret"})
+	if n, token := p.Next("users", &Page{Size: 2}, 2, nil); n != 2 || token != "" {
+		t.Errorf("Next() of a last page = %d, %q, want 2 and no token", n, token)
+	}
+
+	at := time.Date(2024, 5, 1, 12, 0, 0, 123456000, time.UTC)
+	for _, c := range []Cursor{{ID: 42}, {Time: at, ID: 42}} {
+		token := nextToken(p, "followers:1", 2, c)
+		page, err := p.Page("followers:1", PageRequest{Token: token, Size: 2})
+		if err != nil || page.After == nil || *page.After != c {
+			t.Errorf("Page() of the next token of %+v = %+v, %v", c, page, err)
+		}
+	}
+}
+
+func TestPaginatorTokenInvalid(t *testing.T) {
+	p := newTestPaginator(t, &conf.Pagination{TokenSecret: "secret"})
+	token := nextToken(p, "followers:1", 2, Cursor{ID: 42})
+	payload, sig := token[:strings.IndexByte(token, '.')], token[strings.IndexByte(token, '.')+1:]
+	forged := nextToken(p, "followers:1", 2, Cursor{ID: 7})
+	other := nextToken(newTestPaginator(t, &conf.Pagination{TokenSecret: "other"}), "followers:1", 2, Cursor{ID: 42})
+
+	tests := map[string]string{
+		"no signature":              payload,
+		"empty signature":           payload + ".",
+		"signature of another page": payload + forged[strings.IndexByte(forged, '.'):],
+		"altered payload":           strings.Replace(payload, payload[:1], string(payload[0]^1), 1) + "." + sig,
+		"malformed signature":       payload + ".!" + sig,
+		"signed with another key":   other,
+		"not a token":               "garbage",
+	}
+	for name, tok := range tests {
+		if _, err := p.Page("followers:1", PageRequest{Token: tok}); err != ErrPageTokenInvalid {
+			t.Errorf("Page() of a token with %s = %v, want ErrPageTokenInvalid", name, err)
+		}
+	}
+	// A token is only good for the list it was issued for.
+	for _, scope := range []string{"followers:2", "following:1", "users"} {
+		if _, err := p.Page(scope, PageRequest{Token: token}); err != ErrPageTokenInvalid {
+			t.Errorf("Page() of a followers:1 token on %s = %v, want ErrPageTokenInvalid", scope, err)
+		}
+	}
+	if _, err := p.Page("followers:1", PageRequest{Token: token}); err != nil {
+		t.Errorf("Page() of the untouched token = %v", err)
+	}
+}
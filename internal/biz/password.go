@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"user/internal/conf"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	algorithmArgon2id = "argon2id"
+	algorithmBcrypt   = "bcrypt"
+
+	argon2SaltLen = 16
+	argon2KeyLen  = 32
+	// bcryptMaxBytes is the length past which bcrypt ignores a password.
+	bcryptMaxBytes = 72
+)
+
+// PasswordHasher hashes passwords with the configured algorithm and
+// verifies hashes made with any supported algorithm or parameters.
+type PasswordHasher struct {
+	algorithm  string
+	time       uint32
+	memory     uint32
+	threads    uint8
+	bcryptCost int
+}
+
+// NewPasswordHasher new a PasswordHasher from the auth config, filling in
+// the OWASP recommended defaults for unset parameters.
+func NewPasswordHasher(c *conf.Auth) *PasswordHasher {
+	h := &PasswordHasher{
+		algorithm:  algorithmArgon2id,
+		time:       3,
+		memory:     64 * 1024,
+		threads:    2,
+		bcryptCost: bcrypt.DefaultCost,
+	}
+	p := c.GetPassword()
+	if p.GetAlgorithm() != "" {
+		h.algorithm = p.GetAlgorithm()
+	}
+	if p.GetArgon2Time() > 0 {
+		h.time = p.GetArgon2Time()
+	}
+	if p.GetArgon2Memory() > 0 {
+		h.memory = p.GetArgon2Memory()
+	}
+	if p.GetArgon2Threads() > 0 {
+		h.threads = uint8(p.GetArgon2Threads())
+	}
+	if p.GetBcryptCost() > 0 {
+		h.bcryptCost = int(p.GetBcryptCost())
+	}
+	return h
+}
+
+// validate returns ErrInvalidPassword for a password that does not meet
+// the policy, and ErrPasswordTooLong for one the configured algorithm
+// cannot hash whole.
+func (h *PasswordHasher) validate(password string) error {
+	if n := utf8.RuneCountInString(password); n < minPasswordLen || n > maxPasswordLen {
+		return ErrInvalidPassword
+	}
+	if h.algorithm == algorithmBcrypt && len(password) > bcryptMaxBytes {
+		return ErrPasswordTooLong
+	}
+	return nil
+}
+
+// Hash returns the encoded hash of password.
+func (h *PasswordHasher) Hash(password string) (string, error) {
+	switch h.algorithm {
+	case algorithmBcrypt:
+		b, err := bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost)
+		return string(b), err
+	case algorithmArgon2id:
+		salt := make([]byte, argon2SaltLen)
+		if _, err := rand.Read(salt); err != nil {
+			return "", err
+		}
+		key := argon2.IDKey([]byte(password), salt, h.time, h.memory, h.threads, argon2KeyLen)
+		return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, h.memory, h.time, h.threads,
+			base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+	default:
+		return "", fmt.Errorf("biz: unsupported password algorithm %q", h.algorithm)
+	}
+}
+
+// Verify reports whether password matches encoded, and whether encoded
+// should be replaced by a fresh Hash because the configured algorithm or
+// its parameters changed since it was made.
+func (h *PasswordHasher) Verify(password, encoded string) (ok, rehash bool, err error) {
+	if strings.HasPrefix(encoded, "$argon2id$") {
+		return h.verifyArgon2id(password, encoded)
+	}
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return false, false, fmt.Errorf("biz: unrecognized password hash: %w", err)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password)); err != nil {
+		return false, false, nil
+	}
+	return true, h.algorithm != algorithmBcrypt || cost != h.bcryptCost, nil
+}
+
+func (h *PasswordHasher) verifyArgon2id(password, encoded string) (ok, rehash bool, err error) {
+	var (
+		version      int
+		memory, time uint32
+		threads      uint8
+	)
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return false, false, fmt.Errorf("biz: malformed argon2id hash")
+	}
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return false, false, fmt.Errorf("biz: malformed argon2id hash: %w", err)
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil {
+		return false, false, fmt.Errorf("biz: malformed argon2id hash: %w", err)
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, false, fmt.Errorf("biz: malformed argon2id hash: %w", err)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return false, false, fmt.Errorf("biz: malformed argon2id hash: %w", err)
+	}
+	if version != argon2.Version {
+		return false, false, fmt.Errorf("biz: unsupported argon2 version %d", version)
+	}
+	other := argon2.IDKey([]byte(password), salt, time, memory, threads, uint32(len(key)))
+	if subtle.ConstantTimeCompare(key, other) != 1 {
+		return false, false, nil
+	}
+	rehash = h.algorithm != algorithmArgon2id || memory != h.memory || time != h.time ||
+		threads != h.threads || len(key) != argon2KeyLen
+	return true, rehash, nil
+}
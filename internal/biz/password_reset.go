@@ -0,0 +1,252 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "user/api/user/v1"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	passwordResetTokenBytes = 32
+
+	defaultPasswordResetTokenTTL        = time.Hour
+	defaultPasswordResetRequestInterval = time.Minute
+
+	// passwordResetRequestTimeout bounds the handling of a reset request,
+	// which outlives the request.
+	passwordResetRequestTimeout = time.Minute
+	// passwordResetQueueSize bounds the reset requests waiting to be
+	// handled. Requests arriving when it is full are dropped.
+	passwordResetQueueSize = 64
+)
+
+// ErrPasswordResetTokenInvalid is a password reset token that is unknown,
+// used, expired or for an email the user no longer has.
+var ErrPasswordResetTokenInvalid = errors.BadRequest(v1.ErrorReason_PASSWORD_RESET_TOKEN_INVALID.String(), "password reset token is invalid")
+
+// PasswordReset is the pending password reset of a user, who has at most
+// one. Only the hash of its token is stored.
+type PasswordReset struct {
+	UserID    int64
+	TokenHash string
+	// Email is where the token was sent. The token is void once the user
+	// has changed email.
+	Email     string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// PasswordResetRepo is a PasswordReset repo.
+type PasswordResetRepo interface {
+	// Save stores a reset unless the user has one created after since,
+	// which it replaces otherwise, and reports whether it did.
+	Save(ctx context.Context, pr *PasswordReset, since time.Time) (bool, error)
+	// Find returns the reset of userID, or ErrPasswordResetTokenInvalid.
+	Find(ctx context.Context, userID int64) (*PasswordReset, error)
+	// FindByTokenHash returns the reset of a token hash, or
+	// ErrPasswordResetTokenInvalid.
+	FindByTokenHash(ctx context.Context, hash string) (*PasswordReset, error)
+	Delete(ctx context.Context, userID int64) error
+}
+
+// PasswordResetUsecase lets users who forgot their password set a new one
+// through their email.
+type PasswordResetUsecase struct {
+	repo            PasswordResetRepo
+	users           UserRepo
+	creds           CredentialRepo
+	sessions        *Sessions
+	hasher          *PasswordHasher
+	mailer          Mailer
+	tx              Transaction
+	tokenTTL        time.Duration
+	requestInterval time.Duration
+	url             string
+	queue           chan passwordResetRequest
+	log             *log.Helper
+}
+
+// passwordResetRequest is a queued reset request. ctx carries the values of
+// the request, such as its trace, but not its cancellation.
+type passwordResetRequest struct {
+	ctx   context.Context
+	email string
+}
+
+// NewPasswordResetUsecase new a PasswordResetUsecase.
+func NewPasswordResetUsecase(c *conf.Auth, repo PasswordResetRepo, users UserRepo, creds CredentialRepo, sessions *Sessions, hasher *PasswordHasher, mailer Mailer, tx Transaction, logger log.Logger) *PasswordResetUsecase {
+	uc := &PasswordResetUsecase{
+		repo:            repo,
+		users:           users,
+		creds:           creds,
+		sessions:        sessions,
+		hasher:          hasher,
+		mailer:          mailer,
+		tx:              tx,
+		tokenTTL:        defaultPasswordResetTokenTTL,
+		requestInterval: defaultPasswordResetRequestInterval,
+		url:             c.GetPasswordReset().GetUrl(),
+		queue:           make(chan passwordResetRequest, passwordResetQueueSize),
+		log:             log.NewHelper(logger),
+	}
+	if ttl := c.GetPasswordReset().GetTokenTtl(); ttl != nil {
+		uc.tokenTTL = ttl.AsDuration()
+	}
+	if d := c.GetPasswordReset().GetRequestInterval(); d != nil {
+		uc.requestInterval = d.AsDuration()
+	}
+	return uc
+}
+
+// RequestPasswordReset queues the mailing of a reset token to email, if a
+// user has it. The user is looked up, and the token stored and mailed, by
+// HandlePasswordResets, so that neither the result nor the latency of the
+// request depend on whether the email exists. Requests are dropped when the
+// queue is full, and ignored within the request interval of the last one.
+func (uc *PasswordResetUsecase) RequestPasswordReset(ctx context.Context, email string) {
+	r := passwordResetRequest{ctx: ctx, email: normalizeEmail(email)}
+	select {
+	case uc.queue <- r:
+	default:
+		uc.log.WithContext(ctx).Warn("dropping a password reset request, the queue is full")
+	}
+}
+
+// HandlePasswordResets handles the queued reset requests one at a time
+// until ctx is done. The request being handled is cancelled with ctx, and
+// the requests still queued are dropped.
+func (uc *PasswordResetUsecase) HandlePasswordResets(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			if n := len(uc.queue); n > 0 {
+				uc.log.Warnf("dropping %d queued password reset request(s)", n)
+			}
+			return
+		case r := <-uc.queue:
+			rctx, cancel := context.WithTimeout(valuesContext{Context: ctx, values: r.ctx}, passwordResetRequestTimeout)
+			if err := uc.requestPasswordReset(rctx, r.email); err != nil {
+				uc.log.WithContext(rctx).Errorf("request password reset: %v", err)
+			}
+			cancel()
+		}
+	}
+}
+
+// requestPasswordReset stores and mails a reset token for the user of
+// email, if any.
+func (uc *PasswordResetUsecase) requestPasswordReset(ctx context.Context, email string) error {
+	u, err := uc.users.FindByEmail(ctx, email)
+	if errors.Is(err, ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	token, err := randomToken(passwordResetTokenBytes)
+	if err != nil {
+		return err
+	}
+	t := time.Now()
+	saved, err := uc.repo.Save(ctx, &PasswordReset{
+		UserID:    u.ID,
+		TokenHash: hashToken(token),
+		Email:     u.Email,
+		ExpiresAt: t.Add(uc.tokenTTL),
+	}, t.Add(-uc.requestInterval))
+	if err != nil {
+		return err
+	}
+	if !saved {
+		uc.log.WithContext(ctx).Infof("ignoring password reset of user %d, requested too soon", u.ID)
+		return nil
+	}
+	uc.log.WithContext(ctx).Infof("sending password reset to user %d", u.ID)
+	mail := &Mail{
+		To:      u.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nSet a new password with:\n\n%s\n\nIt expires in %s and signs you out everywhere. If you did not ask for it, ignore this email.\n",
+			u.Username, tokenLink(uc.url, token), uc.tokenTTL),
+	}
+	if err := uc.mailer.Send(ctx, mail); err != nil {
+		return fmt.Errorf("send to user %d: %w", u.ID, err)
+	}
+	return nil
+}
+
+// ConfirmPasswordReset consumes a reset token, sets the password of its
+// user and revokes all their sessions. A token is spent even when it has
+// expired or the user has changed email since.
+func (uc *PasswordResetUsecase) ConfirmPasswordReset(ctx context.Context, token, password string) error {
+	if err := uc.hasher.validate(password); err != nil {
+		return err
+	}
+	hash, err := uc.hasher.Hash(password)
+	if err != nil {
+		return err
+	}
+	var (
+		userID  int64
+		revoked []string
+	)
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		pr, err := uc.repo.FindByTokenHash(ctx, hashToken(token))
+		if err != nil {
+			return err
+		}
+		if err := uc.repo.Delete(ctx, pr.UserID); err != nil {
+			return err
+		}
+		if !pr.ExpiresAt.After(time.Now()) {
+			return nil
+		}
+		u, err := uc.users.FindByID(ctx, pr.UserID)
+		if errors.Is(err, ErrUserNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if u.Email != pr.Email {
+			return nil
+		}
+		c := &Credential{UserID: u.ID, PasswordHash: hash}
+		err = uc.creds.Update(ctx, c)
+		if errors.Is(err, ErrCredentialNotFound) {
+			err = uc.creds.Save(ctx, c)
+		}
+		if err != nil {
+			return err
+		}
+		if revoked, err = uc.sessions.revokeAll(ctx, u.ID, ""); err != nil {
+			return err
+		}
+		userID = u.ID
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if userID == 0 {
+		return ErrPasswordResetTokenInvalid
+	}
+	uc.sessions.forget(ctx, revoked...)
+	uc.log.WithContext(ctx).Infof("ConfirmPasswordReset: %d", userID)
+	return nil
+}
+
+// valuesContext is a context with the deadline and cancellation of Context
+// but the values of another one.
+type valuesContext struct {
+	context.Context
+	values context.Context
+}
+
+func (c valuesContext) Value(key interface{}) interface{} {
+	return c.values.Value(key)
+}
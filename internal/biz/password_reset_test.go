@@ -0,0 +1,106 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const resetSubject = "Reset your password"
+
+// requestReset requests a reset for email and returns the token mailed to
+// email.
+func requestReset(t *testing.T, a *testApp, email string) string {
+	t.Helper()
+	a.resets.RequestPasswordReset(context.Background(), email)
+	mail := a.mailer.next(t, resetSubject)
+	if mail.To != email {
+		t.Fatalf("reset mailed to %s, want %s", mail.To, email)
+	}
+	return mailToken(t, mail)
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	a := newTestApp(t, nil)
+	a.register(t, "jane", "jane@example.com", false)
+	a.register(t, "joe", "joe@example.com", false)
+	ctx := context.Background()
+
+	// Requests are handled in order, so the mail to joe comes after the
+	// requests before it were handled.
+	a.resets.RequestPasswordReset(ctx, "nobody@example.com")
+	a.resets.RequestPasswordReset(ctx, "Jane@Example.com")
+	a.resets.RequestPasswordReset(ctx, "jane@example.com")
+	a.resets.RequestPasswordReset(ctx, "joe@example.com")
+	var to []string
+	for {
+		mail := a.mailer.next(t, resetSubject)
+		to = append(to, mail.To)
+		if mail.To == "joe@example.com" {
+			break
+		}
+	}
+	if len(to) != 2 || to[0] != "jane@example.com" {
+		t.Errorf("resets mailed to %v, want one to jane within the request interval, then joe", to)
+	}
+}
+
+func TestConfirmPasswordReset(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", false)
+	ctx := context.Background()
+	token := requestReset(t, a, jane.Email)
+
+	if err := a.resets.ConfirmPasswordReset(ctx, token, "new password"); err != nil {
+		t.Fatalf("ConfirmPasswordReset() error = %v", err)
+	}
+	if _, _, _, err := a.auth.Login(ctx, jane.Email, "new password"); err != nil {
+		t.Errorf("Login() with the new password = %v", err)
+	}
+	if err := a.resets.ConfirmPasswordReset(ctx, token, "other password"); !errors.Is(err, biz.ErrPasswordResetTokenInvalid) {
+		t.Errorf("ConfirmPasswordReset() with a used token = %v, want ErrPasswordResetTokenInvalid", err)
+	}
+	if err := a.resets.ConfirmPasswordReset(ctx, "unknown", "other password"); !errors.Is(err, biz.ErrPasswordResetTokenInvalid) {
+		t.Errorf("ConfirmPasswordReset() with an unknown token = %v, want ErrPasswordResetTokenInvalid", err)
+	}
+}
+
+func TestConfirmPasswordResetExpired(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", false)
+	ctx := context.Background()
+	token := requestReset(t, a, jane.Email)
+	pr, err := a.resetRepo.Find(ctx, jane.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pr.ExpiresAt = time.Now().Add(-time.Second)
+	if _, err := a.resetRepo.Save(ctx, pr, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.resets.ConfirmPasswordReset(ctx, token, "new password"); !errors.Is(err, biz.ErrPasswordResetTokenInvalid) {
+		t.Errorf("ConfirmPasswordReset() with an expired token = %v, want ErrPasswordResetTokenInvalid", err)
+	}
+	if _, _, _, err := a.auth.Login(ctx, jane.Email, "password123"); err != nil {
+		t.Errorf("Login() with the old password = %v", err)
+	}
+}
+
+func TestConfirmPasswordResetAfterEmailChange(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", false)
+	ctx := context.Background()
+	token := requestReset(t, a, jane.Email)
+	jane.Email = "jane@example.org"
+	if _, err := a.users.Update(ctx, jane, []string{biz.PathEmail}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.resets.ConfirmPasswordReset(ctx, token, "new password"); !errors.Is(err, biz.ErrPasswordResetTokenInvalid) {
+		t.Errorf("ConfirmPasswordReset() after an email change = %v, want ErrPasswordResetTokenInvalid", err)
+	}
+}
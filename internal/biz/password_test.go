@@ -0,0 +1,36 @@
+package biz
+
+import (
+	"strings"
+	"testing"
+
+	"user/internal/conf"
+)
+
+func TestPasswordHasherValidate(t *testing.T) {
+	argon2id := NewPasswordHasher(&conf.Auth{})
+	bcrypt := NewPasswordHasher(&conf.Auth{Password: &conf.Auth_Password{Algorithm: "bcrypt", BcryptCost: 4}})
+	tests := []struct {
+		name     string
+		password string
+		// argon2id and bcrypt are the errors of either hasher, compared as
+		// is since both share a reason.
+		argon2id, bcrypt error
+	}{
+		{"short", "short", ErrInvalidPassword, ErrInvalidPassword},
+		{"ascii", "password123", nil, nil},
+		{"72 bytes", strings.Repeat("a", 72), nil, nil},
+		{"73 bytes", strings.Repeat("a", 73), nil, ErrPasswordTooLong},
+		// 40 characters of two bytes each.
+		{"multibyte", strings.Repeat("é", 40), nil, ErrPasswordTooLong},
+		{"129 characters", strings.Repeat("a", 129), ErrInvalidPassword, ErrInvalidPassword},
+	}
+	for _, tt := range tests {
+		if err := argon2id.validate(tt.password); err != tt.argon2id {
+			t.Errorf("argon2id validate(%s) = %v, want %v", tt.name, err, tt.argon2id)
+		}
+		if err := bcrypt.validate(tt.password); err != tt.bcrypt {
+			t.Errorf("bcrypt validate(%s) = %v, want %v", tt.name, err, tt.bcrypt)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package biz
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	// Embedded so that time zones validate without a system zoneinfo.
+	_ "time/tzdata"
+	"unicode"
+	"unicode/utf8"
+
+	v1 "user/api/user/v1"
+	"github.com/go-kratos/kratos/v2/errors"
+	"golang.org/x/text/language"
+)
+
+// BirthDateLayout is the layout of birth dates in the API.
+const BirthDateLayout = "2006-01-02"
+
+const (
+	maxDisplayNameLen = 50
+	maxBioLen         = 300
+	maxLocationLen    = 100
+	maxURLLen         = 2048
+	maxWebsiteLen     = 200
+)
+
+// minBirthDate is the earliest birth date accepted.
+var minBirthDate = time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Profile is the public, user editable profile of a User. Every field is
+// optional.
+type Profile struct {
+	DisplayName string
+	Bio         string
+	AvatarURL   string
+	Location    string
+	Website     string
+	// BirthDate is a date at midnight UTC, zero when unset.
+	BirthDate time.Time
+	// Locale is a BCP 47 language tag.
+	Locale string
+	// Timezone is an IANA time zone name.
+	Timezone string
+}
+
+// invalidProfile is the error of a profile field breaking a rule.
+func invalidProfile(field, format string, args ...interface{}) error {
+	return errors.BadRequest(v1.ErrorReason_INVALID_PROFILE.String(), field+" "+fmt.Sprintf(format, args...)).
+		WithMetadata(map[string]string{"field": field})
+}
+
+// normalize trims the text fields of p and canonicalizes its locale, then
+// checks every field.
+func (p *Profile) normalize() error {
+	for _, f := range []*string{&p.DisplayName, &p.Bio, &p.AvatarURL, &p.Location, &p.Website, &p.Locale, &p.Timezone} {
+		*f = strings.TrimSpace(*f)
+	}
+	if err := checkText("display_name", p.DisplayName, maxDisplayNameLen, false); err != nil {
+		return err
+	}
+	if err := checkText("bio", p.Bio, maxBioLen, true); err != nil {
+		return err
+	}
+	if err := checkText("location", p.Location, maxLocationLen, false); err != nil {
+		return err
+	}
+	if err := checkURL("avatar_url", p.AvatarURL, maxURLLen); err != nil {
+		return err
+	}
+	if err := checkURL("website", p.Website, maxWebsiteLen); err != nil {
+		return err
+	}
+	if !p.BirthDate.IsZero() {
+		if p.BirthDate.Before(minBirthDate) || p.BirthDate.After(time.Now()) {
+			return invalidProfile("birth_date", "must be between %s and today", minBirthDate.Format(BirthDateLayout))
+		}
+	}
+	if p.Locale != "" {
+		tag, err := language.Parse(p.Locale)
+		if err != nil {
+			return invalidProfile("locale", "must be a BCP 47 language tag")
+		}
+		p.Locale = tag.String()
+	}
+	if p.Timezone != "" {
+		if _, err := time.LoadLocation(p.Timezone); err != nil || p.Timezone == "Local" {
+			return invalidProfile("timezone", "must be an IANA time zone name")
+		}
+	}
+	return nil
+}
+
+// ParseBirthDate parses a birth date of the API, empty for none.
+func ParseBirthDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(BirthDateLayout, s)
+	if err != nil {
+		return time.Time{}, invalidProfile("birth_date", "must be a date as YYYY-MM-DD")
+	}
+	return t, nil
+}
+
+// checkText checks the length of a text field, and that it has no control
+// characters other than newlines where multiline.
+func checkText(field, s string, max int, multiline bool) error {
+	if utf8.RuneCountInString(s) > max {
+		return invalidProfile(field, "must be at most %d characters", max)
+	}
+	if !utf8.ValidString(s) {
+		return invalidProfile(field, "must be valid UTF-8")
+	}
+	for _, r := range s {
+		if unicode.IsControl(r) && !(multiline && r == '\n') {
+			return invalidProfile(field, "must not contain control characters")
+		}
+	}
+	return nil
+}
+
+// checkURL checks that s is empty or an absolute http(s) URL.
+func checkURL(field, s string, max int) error {
+	if s == "" {
+		return nil
+	}
+	if len(s) > max {
+		return invalidProfile(field, "must be at most %d characters", max)
+	}
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || u.User != nil {
+		return invalidProfile(field, "must be an http or https URL")
+	}
+	return nil
+}
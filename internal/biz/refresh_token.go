@@ -0,0 +1,193 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "user/api/user/v1"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	defaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+	refreshTokenBytes = 32
+	sessionIDBytes    = 16
+)
+
+var (
+	// ErrRefreshTokenInvalid is an unknown, expired, revoked or reused refresh token.
+	ErrRefreshTokenInvalid = errors.Unauthorized(v1.ErrorReason_REFRESH_TOKEN_INVALID.String(), "refresh token is invalid")
+	// ErrRefreshTokenNotFound is a refresh token hash that is not stored.
+	ErrRefreshTokenNotFound = errors.NotFound(v1.ErrorReason_REFRESH_TOKEN_INVALID.String(), "refresh token not found")
+	// ErrSessionNotFound is session not found.
+	ErrSessionNotFound = errors.NotFound(v1.ErrorReason_SESSION_NOT_FOUND.String(), "session not found")
+)
+
+// RefreshToken is a stored refresh token. Only the hash of the token handed
+// to the client is kept. Every rotation adds a token to the same family,
+// which is the session of one login.
+type RefreshToken struct {
+	ID        int64
+	UserID    int64
+	FamilyID  string
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	// RotatedAt is set once the token has been exchanged.
+	RotatedAt *time.Time
+	// RevokedAt is set when the whole family has been revoked.
+	RevokedAt *time.Time
+}
+
+// RefreshTokenRepo is a RefreshToken repo.
+type RefreshTokenRepo interface {
+	Save(context.Context, *RefreshToken) (*RefreshToken, error)
+	FindByHash(context.Context, string) (*RefreshToken, error)
+	// MarkRotated sets RotatedAt of a token that has not been rotated yet,
+	// and reports whether it did.
+	MarkRotated(ctx context.Context, id int64, at time.Time) (bool, error)
+	// RevokeFamily revokes every token of the user's family, and returns
+	// how many tokens the family has.
+	RevokeFamily(ctx context.Context, userID int64, familyID string, at time.Time) (int64, error)
+	// RevokeAll revokes every token of the user, and returns how many it
+	// revoked.
+	RevokeAll(ctx context.Context, userID int64, at time.Time) (int64, error)
+}
+
+// Tokens are the credentials handed to a client for a session.
+type Tokens struct {
+	AccessToken  *AccessToken
+	RefreshToken string
+	SessionID    string
+}
+
+func refreshTokenTTL(c *conf.Auth) time.Duration {
+	if c.GetRefreshTokenTtl() != nil {
+		return c.GetRefreshTokenTtl().AsDuration()
+	}
+	return defaultRefreshTokenTTL
+}
+
+// startSession opens a new session, and refresh token family, for the
+// user.
+func (uc *AuthUsecase) startSession(ctx context.Context, userID int64) (*Tokens, error) {
+	return uc.openSession(ctx, &Session{UserID: userID})
+}
+
+// openSession opens the session s, with a new id and refresh token family.
+func (uc *AuthUsecase) openSession(ctx context.Context, s *Session) (*Tokens, error) {
+	familyID, err := randomToken(sessionIDBytes)
+	if err != nil {
+		return nil, err
+	}
+	ns := *s
+	ns.ID = familyID
+	ns.ExpiresAt = time.Now().Add(uc.refreshTTL)
+	var tokens *Tokens
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.sessions.start(ctx, &ns); err != nil {
+			return err
+		}
+		tokens, err = uc.issueTokens(ctx, &ns)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// issueTokens stores a new refresh token in the family of the session and
+// signs the access token that goes with it.
+func (uc *AuthUsecase) issueTokens(ctx context.Context, s *Session) (*Tokens, error) {
+	refresh, err := randomToken(refreshTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+	_, err = uc.refreshTokens.Save(ctx, &RefreshToken{
+		UserID:    s.UserID,
+		FamilyID:  s.ID,
+		TokenHash: hashToken(refresh),
+		ExpiresAt: time.Now().Add(uc.refreshTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+	access, err := uc.tokens.issue(&Principal{UserID: s.UserID, SessionID: s.ID, ClientID: s.ClientID, Scope: s.Scope})
+	if err != nil {
+		return nil, err
+	}
+	return &Tokens{AccessToken: access, RefreshToken: refresh, SessionID: s.ID}, nil
+}
+
+// RefreshToken rotates a refresh token: it is exchanged once for a new
+// access token and a new refresh token of the same session. Presenting an
+// already rotated token means it leaked, so the whole session is revoked.
+func (uc *AuthUsecase) RefreshToken(ctx context.Context, token string) (*Tokens, error) {
+	tokens, _, err := uc.rotate(ctx, token, "")
+	return tokens, err
+}
+
+// rotate is RefreshToken for the sessions of an OAuth client, or of the
+// service itself if clientID is empty, and also returns the session.
+func (uc *AuthUsecase) rotate(ctx context.Context, token, clientID string) (*Tokens, *Session, error) {
+	var (
+		tokens  *Tokens
+		session *Session
+		reused  *RefreshToken
+	)
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		rt, err := uc.refreshTokens.FindByHash(ctx, hashToken(token))
+		if errors.Is(err, ErrRefreshTokenNotFound) {
+			return ErrRefreshTokenInvalid
+		}
+		if err != nil {
+			return err
+		}
+		t := time.Now()
+		if rt.RevokedAt != nil || !t.Before(rt.ExpiresAt) {
+			return ErrRefreshTokenInvalid
+		}
+		session, err = uc.sessions.repo.Find(ctx, rt.FamilyID)
+		if errors.Is(err, ErrSessionNotFound) {
+			return ErrRefreshTokenInvalid
+		}
+		if err != nil {
+			return err
+		}
+		if session.ClientID != clientID || session.RevokedAt != nil {
+			return ErrRefreshTokenInvalid
+		}
+		ok := rt.RotatedAt == nil
+		if ok {
+			if ok, err = uc.refreshTokens.MarkRotated(ctx, rt.ID, t); err != nil {
+				return err
+			}
+		}
+		if !ok {
+			// Commit the revocation, the error is returned after the
+			// transaction.
+			reused = rt
+			err := uc.sessions.revoke(ctx, rt.UserID, rt.FamilyID)
+			if errors.Is(err, ErrSessionNotFound) {
+				return nil
+			}
+			return err
+		}
+		if tokens, err = uc.issueTokens(ctx, session); err != nil {
+			return err
+		}
+		return uc.sessions.extend(ctx, rt.FamilyID, t.Add(uc.refreshTTL))
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if reused != nil {
+		uc.sessions.forget(ctx, reused.FamilyID)
+		uc.log.WithContext(ctx).Warnf("refresh token reuse detected, revoked session %s of user %d", reused.FamilyID, reused.UserID)
+		return nil, nil, ErrRefreshTokenInvalid
+	}
+	return tokens, session, nil
+}
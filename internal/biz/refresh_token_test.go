@@ -0,0 +1,82 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestRefreshTokenRotation(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	tokens := a.login(t, jane)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		next, err := a.auth.RefreshToken(ctx, tokens.RefreshToken)
+		if err != nil {
+			t.Fatalf("RefreshToken() %d error = %v", i, err)
+		}
+		if next.SessionID != tokens.SessionID || next.RefreshToken == tokens.RefreshToken || next.AccessToken == nil {
+			t.Fatalf("RefreshToken() %d = %+v, want a new refresh token of session %s", i, next, tokens.SessionID)
+		}
+		tokens = next
+	}
+	if _, err := a.auth.RefreshToken(ctx, "unknown"); !errors.Is(err, biz.ErrRefreshTokenInvalid) {
+		t.Errorf("RefreshToken() of an unknown token = %v, want ErrRefreshTokenInvalid", err)
+	}
+}
+
+func TestRefreshTokenReuse(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	first := a.login(t, jane)
+	other := a.login(t, jane)
+	ctx := context.Background()
+	second, err := a.auth.RefreshToken(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Reusing a rotated token revokes its whole family, the latest token
+	// included, but not the other sessions of the user.
+	if _, err := a.auth.RefreshToken(ctx, first.RefreshToken); !errors.Is(err, biz.ErrRefreshTokenInvalid) {
+		t.Fatalf("RefreshToken() of a rotated token = %v, want ErrRefreshTokenInvalid", err)
+	}
+	if _, err := a.auth.RefreshToken(ctx, second.RefreshToken); !errors.Is(err, biz.ErrRefreshTokenInvalid) {
+		t.Errorf("RefreshToken() of the latest token of a reused family = %v, want ErrRefreshTokenInvalid", err)
+	}
+	if err := a.sessions.Check(ctx, &biz.Principal{UserID: jane.ID, SessionID: first.SessionID}); !errors.Is(err, biz.ErrSessionRevoked) {
+		t.Errorf("Check() of the reused session = %v, want ErrSessionRevoked", err)
+	}
+	if _, err := a.auth.RefreshToken(ctx, other.RefreshToken); err != nil {
+		t.Errorf("RefreshToken() of another session = %v", err)
+	}
+}
+
+func TestRefreshTokenExpired(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	tokens := a.login(t, jane)
+	ctx := context.Background()
+	_, err := a.refreshTokens.Save(ctx, &biz.RefreshToken{
+		UserID:    jane.ID,
+		FamilyID:  tokens.SessionID,
+		TokenHash: biz.HashToken("expired"),
+		ExpiresAt: time.Now().Add(-time.Second),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := a.auth.RefreshToken(ctx, "expired"); !errors.Is(err, biz.ErrRefreshTokenInvalid) {
+		t.Errorf("RefreshToken() of an expired token = %v, want ErrRefreshTokenInvalid", err)
+	}
+	// An expired token is no reuse, so its family lives on.
+	if _, err := a.auth.RefreshToken(ctx, tokens.RefreshToken); err != nil {
+		t.Errorf("RefreshToken() after an expired token = %v", err)
+	}
+}
@@ -0,0 +1,348 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "user/api/user/v1"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// lastSeenResolution is how stale the LastSeenAt of a session may get
+// before a request of the session updates it.
+const lastSeenResolution = time.Minute
+
+// ErrSessionRevoked is an access token of a revoked session.
+var ErrSessionRevoked = errors.Unauthorized(v1.ErrorReason_SESSION_REVOKED.String(), "session has been revoked")
+
+// Client is the client of a request, as seen by the server.
+type Client struct {
+	IP        string
+	UserAgent string
+}
+
+type clientKey struct{}
+
+// NewClientContext returns a context carrying the client of the request.
+func NewClientContext(ctx context.Context, c *Client) context.Context {
+	return context.WithValue(ctx, clientKey{}, c)
+}
+
+// ClientFromContext returns the client of the request of ctx, or an empty
+// one.
+func ClientFromContext(ctx context.Context) *Client {
+	if c, ok := ctx.Value(clientKey{}).(*Client); ok {
+		return c
+	}
+	return &Client{}
+}
+
+// Session is a login of a user on a device, whose ID is the refresh token
+// family of the login.
+type Session struct {
+	ID     string
+	UserID int64
+	// Device is a short description of the client derived from UserAgent.
+	Device    string
+	UserAgent string
+	// IP is the address of the latest request seen of the session.
+	IP         string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	// ExpiresAt is when the latest refresh token of the session expires.
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	// ClientID is the OAuth client the session was authorized for, and
+	// Scope the space separated scopes it was granted. Both are empty for
+	// logins to the service itself.
+	ClientID string
+	Scope    string
+}
+
+// SessionRepo is a Session repo.
+type SessionRepo interface {
+	Save(context.Context, *Session) (*Session, error)
+	// Find returns a session, revoked or not, or ErrSessionNotFound.
+	Find(ctx context.Context, id string) (*Session, error)
+	// List returns the unrevoked and unexpired sessions of userID, the most
+	// recently seen first.
+	List(ctx context.Context, userID int64, at time.Time) ([]*Session, error)
+	// Touch sets the LastSeenAt and IP of a session, and its ExpiresAt
+	// unless zero.
+	Touch(ctx context.Context, id string, at time.Time, ip string, expiresAt time.Time) error
+	// Revoke revokes a session of userID, and returns ErrSessionNotFound if
+	// it has no such unrevoked session.
+	Revoke(ctx context.Context, userID int64, id string, at time.Time) error
+	// RevokeAll revokes the unrevoked sessions of userID but except, and
+	// returns their ids.
+	RevokeAll(ctx context.Context, userID int64, except string, at time.Time) ([]string, error)
+	// RevokeClient revokes the unrevoked sessions of an OAuth client, and
+	// returns them.
+	RevokeClient(ctx context.Context, clientID string, at time.Time) ([]*Session, error)
+}
+
+// SessionCache remembers the revoked sessions for as long as access tokens
+// issued for them may still be valid, which spares the auth middleware a
+// database read on every request.
+type SessionCache interface {
+	Revoke(ctx context.Context, ids []string, ttl time.Duration) error
+	Revoked(ctx context.Context, id string) (bool, error)
+}
+
+// Sessions keeps the sessions of users along with their refresh tokens,
+// and ends the revoked ones.
+type Sessions struct {
+	repo          SessionRepo
+	refreshTokens RefreshTokenRepo
+	cache         SessionCache
+	// accessTTL is how long an access token outlives the revocation of its
+	// session.
+	accessTTL time.Duration
+	// touched holds when each session of this replica was last touched,
+	// within lastSeenResolution of sweptAt.
+	mu      sync.Mutex
+	touched map[string]time.Time
+	sweptAt time.Time
+	log     *log.Helper
+}
+
+// NewSessions new a Sessions.
+func NewSessions(repo SessionRepo, refreshTokens RefreshTokenRepo, cache SessionCache, tokens *TokenManager, logger log.Logger) *Sessions {
+	return &Sessions{
+		repo:          repo,
+		refreshTokens: refreshTokens,
+		cache:         cache,
+		accessTTL:     tokens.ttl,
+		touched:       make(map[string]time.Time),
+		log:           log.NewHelper(logger),
+	}
+}
+
+// Check returns ErrSessionRevoked if the session of p was revoked, and
+// notes it was seen. Failures of the cache are logged and let the request
+// through: the access tokens of revoked sessions expire soon anyway.
+// Principals of no session are rejected with ErrTokenInvalid.
+func (s *Sessions) Check(ctx context.Context, p *Principal) error {
+	if p.SessionID == "" {
+		return ErrTokenInvalid
+	}
+	revoked, err := s.cache.Revoked(ctx, p.SessionID)
+	if err != nil {
+		s.log.WithContext(ctx).Warnf("check session %s: %v", p.SessionID, err)
+	}
+	if revoked {
+		return ErrSessionRevoked
+	}
+	t := time.Now()
+	if !s.touch(p.SessionID, t, false) {
+		return nil
+	}
+	if err := s.repo.Touch(ctx, p.SessionID, t, ClientFromContext(ctx).IP, time.Time{}); err != nil {
+		s.log.WithContext(ctx).Warnf("touch session %s: %v", p.SessionID, err)
+	}
+	return nil
+}
+
+// touch notes the session id was touched at t, unless force is false and
+// it was touched within lastSeenResolution, and reports whether it did.
+// Sessions not touched within lastSeenResolution are swept along the way,
+// as they would be touched again anyway.
+func (s *Sessions) touch(id string, t time.Time, force bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t.Sub(s.sweptAt) >= lastSeenResolution {
+		for id, last := range s.touched {
+			if t.Sub(last) >= lastSeenResolution {
+				delete(s.touched, id)
+			}
+		}
+		s.sweptAt = t
+	}
+	if last, ok := s.touched[id]; ok && !force && t.Sub(last) < lastSeenResolution {
+		return false
+	}
+	s.touched[id] = t
+	return true
+}
+
+// start records the new session ses of the client of ctx.
+func (s *Sessions) start(ctx context.Context, ses *Session) error {
+	c := ClientFromContext(ctx)
+	ns := *ses
+	ns.Device = deviceOf(c.UserAgent)
+	ns.UserAgent = c.UserAgent
+	ns.IP = c.IP
+	_, err := s.repo.Save(ctx, &ns)
+	return err
+}
+
+// extend notes a session was refreshed until expiresAt.
+func (s *Sessions) extend(ctx context.Context, id string, expiresAt time.Time) error {
+	t := time.Now()
+	s.touch(id, t, true)
+	return s.repo.Touch(ctx, id, t, ClientFromContext(ctx).IP, expiresAt)
+}
+
+// revoke revokes a session of userID and its refresh tokens. Callers run
+// it in a transaction, and then forget the session.
+func (s *Sessions) revoke(ctx context.Context, userID int64, id string) error {
+	t := time.Now()
+	if err := s.repo.Revoke(ctx, userID, id, t); err != nil {
+		return err
+	}
+	_, err := s.refreshTokens.RevokeFamily(ctx, userID, id, t)
+	return err
+}
+
+// revokeAll revokes the sessions of userID but except, and all their
+// refresh tokens, and returns their ids. Callers run it in a transaction,
+// and then forget the sessions.
+func (s *Sessions) revokeAll(ctx context.Context, userID int64, except string) ([]string, error) {
+	t := time.Now()
+	ids, err := s.repo.RevokeAll(ctx, userID, except, t)
+	if err != nil {
+		return nil, err
+	}
+	if except == "" {
+		_, err := s.refreshTokens.RevokeAll(ctx, userID, t)
+		return ids, err
+	}
+	for _, id := range ids {
+		if _, err := s.refreshTokens.RevokeFamily(ctx, userID, id, t); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+// revokeClient revokes the sessions of an OAuth client and their refresh
+// tokens, and returns their ids. Callers run it in a transaction, and then
+// forget the sessions.
+func (s *Sessions) revokeClient(ctx context.Context, clientID string) ([]string, error) {
+	t := time.Now()
+	ss, err := s.repo.RevokeClient(ctx, clientID, t)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(ss))
+	for _, ses := range ss {
+		if _, err := s.refreshTokens.RevokeFamily(ctx, ses.UserID, ses.ID, t); err != nil {
+			return nil, err
+		}
+		ids = append(ids, ses.ID)
+	}
+	return ids, nil
+}
+
+// forget makes the auth middleware reject the access tokens of revoked
+// sessions, and stops tracking when they were touched. Failures are
+// logged, the tokens then live out their short lifetime.
+func (s *Sessions) forget(ctx context.Context, ids ...string) {
+	if len(ids) == 0 {
+		return
+	}
+	s.mu.Lock()
+	for _, id := range ids {
+		delete(s.touched, id)
+	}
+	s.mu.Unlock()
+	if err := s.cache.Revoke(ctx, ids, s.accessTTL); err != nil {
+		s.log.WithContext(ctx).Errorf("cache %d revoked sessions: %v", len(ids), err)
+	}
+}
+
+// ListSessions returns the active sessions of the authenticated user, and
+// the id of the one of the request.
+func (uc *AuthUsecase) ListSessions(ctx context.Context) ([]*Session, string, error) {
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, "", ErrUnauthenticated
+	}
+	ss, err := uc.sessions.repo.List(ctx, p.UserID, time.Now())
+	if err != nil {
+		return nil, "", err
+	}
+	return ss, p.SessionID, nil
+}
+
+// RevokeSession revokes a session of the authenticated user, which may be
+// the one of the request.
+func (uc *AuthUsecase) RevokeSession(ctx context.Context, sessionID string) error {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		return uc.sessions.revoke(ctx, userID, sessionID)
+	})
+	if err != nil {
+		return err
+	}
+	uc.sessions.forget(ctx, sessionID)
+	return nil
+}
+
+// RevokeAllOtherSessions revokes every session of the authenticated user
+// but the one of the request, and returns how many it revoked.
+func (uc *AuthUsecase) RevokeAllOtherSessions(ctx context.Context) (int, error) {
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	var ids []string
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		var err error
+		ids, err = uc.sessions.revokeAll(ctx, p.UserID, p.SessionID)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	uc.sessions.forget(ctx, ids...)
+	uc.log.WithContext(ctx).Infof("RevokeAllOtherSessions: %d revoked %d", p.UserID, len(ids))
+	return len(ids), nil
+}
+
+// deviceOf describes the client of a user agent as "Browser on OS", or
+// with what it has of either.
+func deviceOf(ua string) string {
+	if ua == "" {
+		return ""
+	}
+	browser := firstMatch(ua, [][2]string{
+		{"Edg/", "Edge"}, {"OPR/", "Opera"}, {"Firefox/", "Firefox"}, {"Chrome/", "Chrome"},
+		{"Safari/", "Safari"}, {"curl/", "curl"}, {"okhttp/", "OkHttp"}, {"grpc-", "gRPC"},
+	})
+	os := firstMatch(ua, [][2]string{
+		{"iPhone", "iPhone"}, {"iPad", "iPad"}, {"Android", "Android"}, {"Windows", "Windows"},
+		{"Mac OS X", "macOS"}, {"CrOS", "ChromeOS"}, {"Linux", "Linux"},
+	})
+	switch {
+	case browser != "" && os != "":
+		return browser + " on " + os
+	case browser != "":
+		return browser
+	case os != "":
+		return os
+	}
+	// Clients like "app/1.2 (details)" name themselves first.
+	name := ua
+	if i := strings.IndexAny(name, "/ ("); i > 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// firstMatch returns the name of the first of the substring and name pairs
+// whose substring is in s.
+func firstMatch(s string, pairs [][2]string) string {
+	for _, p := range pairs {
+		if strings.Contains(s, p[0]) {
+			return p[1]
+		}
+	}
+	return ""
+}
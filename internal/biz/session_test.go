@@ -0,0 +1,41 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type nopSessionCache struct{}
+
+func (nopSessionCache) Revoke(ctx context.Context, ids []string, ttl time.Duration) error {
+	return nil
+}
+
+func (nopSessionCache) Revoked(ctx context.Context, id string) (bool, error) {
+	return false, nil
+}
+
+func TestSessionsTouch(t *testing.T) {
+	s := &Sessions{cache: nopSessionCache{}, touched: make(map[string]time.Time)}
+	t0 := time.Now()
+	if !s.touch("a", t0, false) {
+		t.Error("touch() of a new session = false")
+	}
+	if s.touch("a", t0.Add(time.Second), false) {
+		t.Error("touch() within lastSeenResolution = true")
+	}
+	if !s.touch("a", t0.Add(time.Second), true) {
+		t.Error("forced touch() = false")
+	}
+	if !s.touch("b", t0.Add(2*lastSeenResolution), false) {
+		t.Error("touch() of another new session = false")
+	}
+	if _, ok := s.touched["a"]; ok || len(s.touched) != 1 {
+		t.Errorf("touched = %v, want the stale session swept", s.touched)
+	}
+	s.forget(context.Background(), "b")
+	if len(s.touched) != 0 {
+		t.Errorf("touched = %v, want the forgotten session removed", s.touched)
+	}
+}
@@ -0,0 +1,279 @@
+package biz
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	v1 "user/api/user/v1"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	defaultAccessTokenTTL = 15 * time.Minute
+
+	// accessTokenType is the typ header of access tokens, as of RFC 9068,
+	// which tells them from the ID tokens that may share their signing key.
+	accessTokenType = "at+jwt"
+)
+
+var (
+	// ErrUnauthenticated is a request without credentials.
+	ErrUnauthenticated = errors.Unauthorized(v1.ErrorReason_UNAUTHENTICATED.String(), "missing access token")
+	// ErrTokenInvalid is a malformed or forged token.
+	ErrTokenInvalid = errors.Unauthorized(v1.ErrorReason_TOKEN_INVALID.String(), "access token is invalid")
+	// ErrTokenExpired is an expired token.
+	ErrTokenExpired = errors.Unauthorized(v1.ErrorReason_TOKEN_EXPIRED.String(), "access token has expired")
+	// ErrPermissionDenied is an authenticated user acting on what they do not own.
+	ErrPermissionDenied = errors.Forbidden(v1.ErrorReason_PERMISSION_DENIED.String(), "permission denied")
+)
+
+// AccessToken is a signed access token.
+type AccessToken struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	// UserID is 0 for the tokens of the client_credentials grant, which
+	// act for their client and no user.
+	UserID int64
+	// SessionID is the refresh token family the access token was issued
+	// for.
+	SessionID string
+	// ClientID is the OAuth client the session was authorized for, and
+	// Scope the space separated scopes it was granted. Both are empty for
+	// logins to the service itself.
+	ClientID string
+	Scope    string
+	// APIKeyID is the API key the request authenticated with, whose scopes
+	// are in Scope, or 0 for access tokens.
+	APIKeyID int64
+}
+
+// Delegated reports whether p acts for its user through an API key or an
+// OAuth client, which may only call the operations of their scopes.
+func (p *Principal) Delegated() bool {
+	return p.APIKeyID != 0 || p.ClientID != ""
+}
+
+// ClientCredentials reports whether p is a token of the
+// client_credentials grant, which has neither a user nor a session.
+func (p *Principal) ClientCredentials() bool {
+	return p.UserID == 0 && p.ClientID != ""
+}
+
+// HasScope reports whether p was granted scope.
+func (p *Principal) HasScope(scope string) bool {
+	return hasScope(p.Scope, scope)
+}
+
+// accessClaims are the claims of an access token.
+type accessClaims struct {
+	SessionID string `json:"sid,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Scope     string `json:"scope,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// TokenManager signs and verifies the JWT access tokens of users.
+type TokenManager struct {
+	method    jwt.SigningMethod
+	signKey   interface{}
+	verifyKey interface{}
+	keyID     string
+	issuer    string
+	ttl       time.Duration
+}
+
+// NewTokenManager new a TokenManager from the auth config.
+func NewTokenManager(c *conf.Auth) (*TokenManager, error) {
+	jc := c.GetJwt()
+	m := &TokenManager{
+		keyID:  jc.GetKeyId(),
+		issuer: jc.GetIssuer(),
+		ttl:    defaultAccessTokenTTL,
+	}
+	if jc.GetAccessTokenTtl() != nil {
+		m.ttl = jc.GetAccessTokenTtl().AsDuration()
+	}
+	switch alg := jc.GetAlgorithm(); alg {
+	case "", "HS256":
+		if jc.GetSecret() == "" {
+			return nil, fmt.Errorf("biz: auth.jwt.secret is required for HS256")
+		}
+		m.method = jwt.SigningMethodHS256
+		m.signKey = []byte(jc.GetSecret())
+		m.verifyKey = m.signKey
+	case "RS256", "EdDSA":
+		pem, err := os.ReadFile(jc.GetPrivateKeyFile())
+		if err != nil {
+			return nil, fmt.Errorf("biz: read auth.jwt.private_key_file: %w", err)
+		}
+		var key crypto.Signer
+		if alg == "RS256" {
+			m.method = jwt.SigningMethodRS256
+			key, err = jwt.ParseRSAPrivateKeyFromPEM(pem)
+		} else {
+			m.method = jwt.SigningMethodEdDSA
+			var k crypto.PrivateKey
+			if k, err = jwt.ParseEdPrivateKeyFromPEM(pem); err == nil {
+				key = k.(crypto.Signer)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("biz: parse auth.jwt.private_key_file: %w", err)
+		}
+		m.signKey = key
+		m.verifyKey = key.Public()
+	default:
+		return nil, fmt.Errorf("biz: unsupported jwt algorithm %q", alg)
+	}
+	return m, nil
+}
+
+// Issue signs an access token for the user and session.
+func (m *TokenManager) Issue(userID int64, sessionID string) (*AccessToken, error) {
+	return m.issue(&Principal{UserID: userID, SessionID: sessionID})
+}
+
+// issue signs an access token for p.
+func (m *TokenManager) issue(p *Principal) (*AccessToken, error) {
+	return m.sign(accessClaims{
+		SessionID: p.SessionID,
+		ClientID:  p.ClientID,
+		Scope:     p.Scope,
+	}, strconv.FormatInt(p.UserID, 10), m.ttl)
+}
+
+// issueClient signs a client_credentials access token for clientID, whose
+// subject is the client itself, lasting ttl.
+func (m *TokenManager) issueClient(clientID, scope string, ttl time.Duration) (*AccessToken, error) {
+	return m.sign(accessClaims{ClientID: clientID, Scope: scope}, clientID, ttl)
+}
+
+// sign signs claims as an access token of subject lasting ttl.
+func (m *TokenManager) sign(claims accessClaims, subject string, ttl time.Duration) (*AccessToken, error) {
+	t := time.Now()
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		Issuer:    m.issuer,
+		Subject:   subject,
+		IssuedAt:  jwt.NewNumericDate(t),
+		NotBefore: jwt.NewNumericDate(t),
+		ExpiresAt: jwt.NewNumericDate(t.Add(ttl)),
+	}
+	token := jwt.NewWithClaims(m.method, claims)
+	token.Header["typ"] = accessTokenType
+	if m.keyID != "" {
+		token.Header["kid"] = m.keyID
+	}
+	s, err := token.SignedString(m.signKey)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessToken{Token: s, ExpiresAt: claims.ExpiresAt.Time}, nil
+}
+
+// Verify checks the signature and validity of an access token, and returns
+// the principal it was issued to. Only the tokens of sessions and of the
+// client_credentials grant are accepted: ID tokens, which may share the
+// signing key, are not.
+func (m *TokenManager) Verify(token string) (*Principal, error) {
+	var claims struct {
+		accessClaims
+		Nonce string `json:"nonce,omitempty"`
+	}
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (interface{}, error) {
+		if t.Method.Alg() != m.method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		if t.Header["typ"] != accessTokenType {
+			return nil, fmt.Errorf("unexpected token type %v", t.Header["typ"])
+		}
+		return m.verifyKey, nil
+	})
+	if err != nil {
+		var ve *jwt.ValidationError
+		if errors.As(err, &ve) && ve.Errors&jwt.ValidationErrorExpired != 0 {
+			return nil, ErrTokenExpired
+		}
+		return nil, ErrTokenInvalid
+	}
+	if m.issuer != "" && !claims.VerifyIssuer(m.issuer, true) {
+		return nil, ErrTokenInvalid
+	}
+	if len(claims.Audience) != 0 || claims.Nonce != "" {
+		return nil, ErrTokenInvalid
+	}
+	if claims.SessionID == "" {
+		if claims.ClientID == "" || claims.Subject != claims.ClientID {
+			return nil, ErrTokenInvalid
+		}
+		return &Principal{ClientID: claims.ClientID, Scope: claims.Scope}, nil
+	}
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil {
+		return nil, ErrTokenInvalid
+	}
+	return &Principal{UserID: userID, SessionID: claims.SessionID, ClientID: claims.ClientID, Scope: claims.Scope}, nil
+}
+
+type principalKey struct{}
+
+// NewPrincipalContext returns a context carrying the authenticated caller.
+func NewPrincipalContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the authenticated caller of ctx, if any.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+// UserIDFromContext returns the authenticated user id of ctx, if any, which
+// client_credentials tokens have not.
+func UserIDFromContext(ctx context.Context) (int64, bool) {
+	if p, ok := PrincipalFromContext(ctx); ok && !p.ClientCredentials() {
+		return p.UserID, true
+	}
+	return 0, false
+}
+
+// authorize checks that the authenticated user of ctx is userID.
+func authorize(ctx context.Context, userID int64) error {
+	id, ok := UserIDFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	if id != userID {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// randomToken returns a URL-safe random string of n bytes of entropy.
+func randomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken returns the hex SHA-256 of a random token. Tokens carry enough
+// entropy that an unsalted fast hash is safe to store and look up by.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
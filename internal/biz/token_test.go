@@ -0,0 +1,114 @@
+package biz
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// newEdDSATokens returns a TokenManager signing with a new Ed25519 key, and
+// the signer of ID tokens falling back to the same key.
+func newEdDSATokens(t *testing.T) (*TokenManager, *signer) {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "key.pem")
+	if err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tokens, err := NewTokenManager(&conf.Auth{Jwt: &conf.Auth_JWT{Algorithm: "EdDSA", PrivateKeyFile: file, Issuer: "user"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := newSigner(&conf.OAuth{}, tokens, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tokens, s
+}
+
+func TestVerifyAcceptsAccessTokens(t *testing.T) {
+	tokens, _ := newEdDSATokens(t)
+	at, err := tokens.issue(&Principal{UserID: 42, SessionID: "s1", ClientID: "c1", Scope: "openid"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := tokens.Verify(at.Token)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if p.UserID != 42 || p.SessionID != "s1" || p.ClientID != "c1" || p.Scope != "openid" {
+		t.Errorf("Verify() = %+v", p)
+	}
+}
+
+func TestVerifyAcceptsClientTokens(t *testing.T) {
+	tokens, _ := newEdDSATokens(t)
+	at, err := tokens.issueClient("c1", ScopeUsersRead, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := tokens.Verify(at.Token)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if !p.ClientCredentials() || p.ClientID != "c1" || p.Scope != ScopeUsersRead || p.SessionID != "" {
+		t.Errorf("Verify() = %+v", p)
+	}
+}
+
+func TestVerifyRejectsOtherTokens(t *testing.T) {
+	tokens, s := newEdDSATokens(t)
+	now := time.Now()
+	registered := jwt.RegisteredClaims{
+		Issuer:    "user",
+		Subject:   "42",
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute)),
+	}
+	idToken := jwt.MapClaims{
+		"iss": "user", "sub": "42", "aud": "c1", "azp": "c1", "nonce": "n",
+		"iat": now.Unix(), "exp": now.Add(time.Minute).Unix(),
+	}
+	tests := []struct {
+		name   string
+		typ    string
+		claims jwt.Claims
+	}{
+		{"id token", idTokenType, idToken},
+		{"id token typed as access token", accessTokenType, idToken},
+		{"access token of no session", accessTokenType, accessClaims{RegisteredClaims: registered}},
+		{"client token of a user", accessTokenType, accessClaims{ClientID: "c1", RegisteredClaims: registered}},
+		{"untyped access token", "", accessClaims{SessionID: "s1", RegisteredClaims: registered}},
+		{"access token with audience", accessTokenType, accessClaims{SessionID: "s1", RegisteredClaims: jwt.RegisteredClaims{
+			Issuer: "user", Subject: "42", Audience: jwt.ClaimStrings{"c1"}, ExpiresAt: registered.ExpiresAt,
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := s.sign(tt.typ, tt.claims)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p, err := tokens.Verify(token); !errors.Is(err, ErrTokenInvalid) {
+				t.Errorf("Verify() = %+v, %v, want ErrTokenInvalid", p, err)
+			}
+		})
+	}
+}
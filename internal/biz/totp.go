@@ -0,0 +1,496 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	v1 "user/api/user/v1"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	// RFC 6238 parameters every authenticator app supports: HMAC-SHA1,
+	// 6 digits and 30 second steps.
+	totpSecretBytes = 20
+	totpDigits      = 6
+	totpPeriod      = 30 * time.Second
+	// totpSkew is how many steps a code may be off, for clock drift.
+	totpSkew = 1
+
+	recoveryCodeCount = 10
+	// recoveryCodeLen characters of recoveryCodeEncoding carry 140 bits,
+	// enough for the unsalted hashes of hashToken, in groups of
+	// recoveryCodeGroup.
+	recoveryCodeLen   = 28
+	recoveryCodeGroup = 7
+
+	challengeTokenBytes = 32
+	// maxChallengeAttempts is how many wrong codes void a challenge, so that
+	// guessing a code takes a password per few attempts.
+	maxChallengeAttempts = 5
+	// maxTOTPAttempts is how many wrong codes within totpLockout refuse
+	// the confirming and disabling of a TOTP for the rest of it, which
+	// unlike a login take no password per few attempts.
+	maxTOTPAttempts = 5
+	totpLockout     = 15 * time.Minute
+
+	defaultChallengeTTL = 5 * time.Minute
+	defaultTOTPIssuer   = "user"
+)
+
+var (
+	// ErrTOTPNotEnabled is a user without a TOTP, or with one still to
+	// confirm where a confirmed one is needed.
+	ErrTOTPNotEnabled = errors.NotFound(v1.ErrorReason_TOTP_NOT_ENABLED.String(), "totp is not enabled")
+	// ErrTOTPAlreadyEnabled is an enrollment of a user with a confirmed TOTP.
+	ErrTOTPAlreadyEnabled = errors.Conflict(v1.ErrorReason_TOTP_ALREADY_ENABLED.String(), "totp is already enabled")
+	// ErrSecondFactorInvalid is a wrong, reused or expired TOTP or recovery
+	// code.
+	ErrSecondFactorInvalid = errors.Unauthorized(v1.ErrorReason_SECOND_FACTOR_INVALID.String(), "second factor code is invalid")
+	// ErrSecondFactorLocked is a code given to confirm or disable a TOTP
+	// after maxTOTPAttempts wrong ones.
+	ErrSecondFactorLocked = errors.New(http.StatusTooManyRequests, v1.ErrorReason_SECOND_FACTOR_LOCKED.String(),
+		"too many wrong second factor codes, try again later")
+	// ErrChallengeInvalid is an unknown, used, expired or exhausted login
+	// challenge.
+	ErrChallengeInvalid = errors.Unauthorized(v1.ErrorReason_CHALLENGE_INVALID.String(), "login challenge is invalid")
+)
+
+// recoveryCodeEncoding is Crockford's base32, which leaves out the letters
+// easily mistaken for digits.
+var recoveryCodeEncoding = base32.NewEncoding("0123456789abcdefghjkmnpqrstvwxyz").WithPadding(base32.NoPadding)
+
+// TOTP is the RFC 6238 secret of a user. The data layer encrypts Secret at
+// rest.
+type TOTP struct {
+	UserID int64
+	Secret []byte
+	// ConfirmedAt is set once a first code proved the user's authenticator
+	// has the secret, which enables it.
+	ConfirmedAt *time.Time
+	// LastUsedStep is the time step of the latest accepted code. Codes of
+	// that step or earlier are rejected, so each is accepted once.
+	LastUsedStep int64
+	// FailedAttempts counts the wrong codes given to confirm or disable it
+	// since FailedAt went back within totpLockout.
+	FailedAttempts int32
+	// FailedAt is the time of the latest wrong code, if any.
+	FailedAt  *time.Time
+	CreatedAt time.Time
+}
+
+// TOTPRepo is a TOTP repo, which also keeps the recovery codes of the
+// users.
+type TOTPRepo interface {
+	// Save stores a TOTP, replacing the one of the user along with their
+	// recovery codes.
+	Save(context.Context, *TOTP) (*TOTP, error)
+	// Find returns the TOTP of userID, or ErrTOTPNotEnabled.
+	Find(ctx context.Context, userID int64) (*TOTP, error)
+	// UseStep sets the LastUsedStep of the TOTP of userID to step, and its
+	// ConfirmedAt to at if unset, if step is after LastUsedStep. It reports
+	// whether it did.
+	UseStep(ctx context.Context, userID, step int64, at time.Time) (bool, error)
+	// AddFailedAttempt counts a wrong code given at at to the TOTP of
+	// userID, counting anew if the previous one was before since, and
+	// returns the count.
+	AddFailedAttempt(ctx context.Context, userID int64, at, since time.Time) (int32, error)
+	// Delete removes the TOTP of userID and their recovery codes.
+	Delete(ctx context.Context, userID int64) error
+	// SaveRecoveryCodes replaces the recovery codes of userID with the
+	// given hashes.
+	SaveRecoveryCodes(ctx context.Context, userID int64, hashes []string) error
+	// UseRecoveryCode removes a recovery code of userID, and reports
+	// whether it had it.
+	UseRecoveryCode(ctx context.Context, userID int64, hash string) (bool, error)
+}
+
+// LoginChallenge is a login whose password was right, awaiting its second
+// factor. Only the hash of its token is stored.
+type LoginChallenge struct {
+	TokenHash string
+	UserID    int64
+	// Attempts counts the wrong codes given.
+	Attempts  int32
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// LoginChallengeRepo is a LoginChallenge repo.
+type LoginChallengeRepo interface {
+	Save(context.Context, *LoginChallenge) error
+	// Find returns the challenge of a token hash, or ErrChallengeInvalid.
+	Find(ctx context.Context, hash string) (*LoginChallenge, error)
+	// AddAttempt counts a wrong code, and returns the attempts so far.
+	AddAttempt(ctx context.Context, hash string) (int32, error)
+	Delete(ctx context.Context, hash string) error
+}
+
+// Challenge is handed to a client whose login awaits a second factor.
+type Challenge struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// TwoFactor holds the TOTP secrets, recovery codes and login challenges of
+// two-factor authentication.
+type TwoFactor struct {
+	repo         TOTPRepo
+	challenges   LoginChallengeRepo
+	issuer       string
+	challengeTTL time.Duration
+}
+
+// NewTwoFactor new a TwoFactor from the auth config.
+func NewTwoFactor(c *conf.Auth, repo TOTPRepo, challenges LoginChallengeRepo) *TwoFactor {
+	f := &TwoFactor{
+		repo:         repo,
+		challenges:   challenges,
+		issuer:       c.GetTotp().GetIssuer(),
+		challengeTTL: defaultChallengeTTL,
+	}
+	if f.issuer == "" {
+		f.issuer = c.GetJwt().GetIssuer()
+	}
+	if f.issuer == "" {
+		f.issuer = defaultTOTPIssuer
+	}
+	if c.GetTotp().GetChallengeTtl() != nil {
+		f.challengeTTL = c.GetTotp().GetChallengeTtl().AsDuration()
+	}
+	return f
+}
+
+// enabled reports whether userID has a confirmed TOTP.
+func (f *TwoFactor) enabled(ctx context.Context, userID int64) (bool, error) {
+	t, err := f.repo.Find(ctx, userID)
+	if errors.Is(err, ErrTOTPNotEnabled) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return t.ConfirmedAt != nil, nil
+}
+
+// challenge opens a login challenge for userID.
+func (f *TwoFactor) challenge(ctx context.Context, userID int64) (*Challenge, error) {
+	token, err := randomToken(challengeTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+	c := &Challenge{Token: token, ExpiresAt: time.Now().Add(f.challengeTTL)}
+	err = f.challenges.Save(ctx, &LoginChallenge{
+		TokenHash: hashToken(token),
+		UserID:    userID,
+		ExpiresAt: c.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// check returns ErrSecondFactorInvalid unless code is a TOTP code of the
+// TOTP of userID not used yet, or, once confirmed, one of their recovery
+// codes, which it uses up. Only TOTP codes confirm a TOTP.
+func (f *TwoFactor) check(ctx context.Context, userID int64, code string, confirmed bool) error {
+	t, err := f.repo.Find(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if confirmed != (t.ConfirmedAt != nil) {
+		if confirmed {
+			return ErrTOTPNotEnabled
+		}
+		return ErrTOTPAlreadyEnabled
+	}
+	code = strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(code)))
+	if len(code) == totpDigits {
+		now := time.Now()
+		step, ok := matchTOTP(t.Secret, code, now)
+		if !ok || step <= t.LastUsedStep {
+			return ErrSecondFactorInvalid
+		}
+		if ok, err = f.repo.UseStep(ctx, userID, step, now); err != nil {
+			return err
+		}
+		if !ok {
+			return ErrSecondFactorInvalid
+		}
+		return nil
+	}
+	if !confirmed || code == "" {
+		return ErrSecondFactorInvalid
+	}
+	ok, err := f.repo.UseRecoveryCode(ctx, userID, hashToken(code))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrSecondFactorInvalid
+	}
+	return nil
+}
+
+// checkLimited is check for the confirming and disabling of a TOTP, which
+// counts wrong codes and returns ErrSecondFactorLocked after
+// maxTOTPAttempts of them within totpLockout. Callers commit even when it
+// returns ErrSecondFactorInvalid, for the count to hold.
+func (f *TwoFactor) checkLimited(ctx context.Context, userID int64, code string, confirmed bool) error {
+	t, err := f.repo.Find(ctx, userID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if t.FailedAttempts >= maxTOTPAttempts && t.FailedAt != nil && t.FailedAt.After(now.Add(-totpLockout)) {
+		return ErrSecondFactorLocked
+	}
+	err = f.check(ctx, userID, code, confirmed)
+	if !errors.Is(err, ErrSecondFactorInvalid) {
+		return err
+	}
+	if _, err := f.repo.AddFailedAttempt(ctx, userID, now, now.Add(-totpLockout)); err != nil {
+		return err
+	}
+	return ErrSecondFactorInvalid
+}
+
+// otpauthURI returns the Key URI authenticator apps scan to add secret.
+func (f *TwoFactor) otpauthURI(account string, secret []byte) string {
+	q := url.Values{}
+	q.Set("secret", encodeTOTPSecret(secret))
+	q.Set("issuer", f.issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(totpDigits))
+	q.Set("period", fmt.Sprint(int(totpPeriod/time.Second)))
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + f.issuer + ":" + account,
+		RawQuery: q.Encode(),
+	}
+	return u.String()
+}
+
+func encodeTOTPSecret(secret []byte) string {
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(secret)
+}
+
+// matchTOTP returns the time step within the skew of at whose code is code.
+func matchTOTP(secret []byte, code string, at time.Time) (int64, bool) {
+	now := at.Unix() / int64(totpPeriod/time.Second)
+	for step := now + totpSkew; step >= now-totpSkew; step-- {
+		if subtle.ConstantTimeCompare([]byte(totpCode(secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// totpCode returns the RFC 4226 code of a time step.
+func totpCode(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	off := sum[len(sum)-1] & 0x0f
+	bin := binary.BigEndian.Uint32(sum[off:off+4]) & 0x7fffffff
+	return fmt.Sprintf("%06d", bin%1000000)
+}
+
+// newRecoveryCodes returns fresh recovery codes, formatted as groups of
+// recoveryCodeGroup characters separated by dashes.
+func newRecoveryCodes() ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, (recoveryCodeLen*5+7)/8)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		s := recoveryCodeEncoding.EncodeToString(b)[:recoveryCodeLen]
+		groups := make([]string, 0, recoveryCodeLen/recoveryCodeGroup)
+		for ; s != ""; s = s[recoveryCodeGroup:] {
+			groups = append(groups, s[:recoveryCodeGroup])
+		}
+		codes[i] = strings.Join(groups, "-")
+	}
+	return codes, nil
+}
+
+// EnrollTOTP gives the authenticated user a new TOTP secret to confirm, and
+// returns it with its otpauth URI. It replaces a pending enrollment.
+func (uc *AuthUsecase) EnrollTOTP(ctx context.Context) (string, string, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return "", "", ErrUnauthenticated
+	}
+	u, err := uc.users.FindByID(ctx, userID)
+	if err != nil {
+		return "", "", err
+	}
+	secret := make([]byte, totpSecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", err
+	}
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		on, err := uc.twoFactor.enabled(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if on {
+			return ErrTOTPAlreadyEnabled
+		}
+		_, err = uc.twoFactor.repo.Save(ctx, &TOTP{UserID: userID, Secret: secret})
+		return err
+	})
+	if err != nil {
+		return "", "", err
+	}
+	uc.log.WithContext(ctx).Infof("EnrollTOTP: %d", userID)
+	return encodeTOTPSecret(secret), uc.twoFactor.otpauthURI(u.Email, secret), nil
+}
+
+// ConfirmTOTP enables the pending TOTP of the authenticated user with a
+// first code, and returns their recovery codes. Wrong codes count towards
+// ErrSecondFactorLocked.
+func (uc *AuthUsecase) ConfirmTOTP(ctx context.Context, code string) ([]string, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	codes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]string, len(codes))
+	for i, c := range codes {
+		hashes[i] = hashToken(strings.ReplaceAll(c, "-", ""))
+	}
+	var failed error
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		err := uc.twoFactor.checkLimited(ctx, userID, code, false)
+		if errors.Is(err, ErrSecondFactorInvalid) {
+			// Commit the attempt, the error is returned after the
+			// transaction.
+			failed = err
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return uc.twoFactor.repo.SaveRecoveryCodes(ctx, userID, hashes)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if failed != nil {
+		return nil, failed
+	}
+	uc.log.WithContext(ctx).Infof("ConfirmTOTP: %d", userID)
+	return codes, nil
+}
+
+// DisableTOTP removes the TOTP and recovery codes of the authenticated user
+// given one of their codes. Wrong codes count towards
+// ErrSecondFactorLocked.
+func (uc *AuthUsecase) DisableTOTP(ctx context.Context, code string) error {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	var failed error
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		err := uc.twoFactor.checkLimited(ctx, userID, code, true)
+		if errors.Is(err, ErrSecondFactorInvalid) {
+			// Commit the attempt, the error is returned after the
+			// transaction.
+			failed = err
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return uc.twoFactor.repo.Delete(ctx, userID)
+	})
+	if err != nil {
+		return err
+	}
+	if failed != nil {
+		return failed
+	}
+	uc.log.WithContext(ctx).Infof("DisableTOTP: %d", userID)
+	return nil
+}
+
+// VerifySecondFactor completes the login of a challenge with a TOTP or
+// recovery code, and returns the User with the tokens of a new session.
+// Wrong codes count against the challenge, which is void after
+// maxChallengeAttempts of them.
+func (uc *AuthUsecase) VerifySecondFactor(ctx context.Context, token, code string) (*User, *Tokens, error) {
+	var (
+		u      *User
+		tokens *Tokens
+		failed error
+	)
+	hash := hashToken(token)
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		c, err := uc.twoFactor.challenges.Find(ctx, hash)
+		if err != nil {
+			return err
+		}
+		if !c.ExpiresAt.After(time.Now()) {
+			return ErrChallengeInvalid
+		}
+		err = uc.twoFactor.check(ctx, c.UserID, code, true)
+		if errors.Is(err, ErrSecondFactorInvalid) || errors.Is(err, ErrTOTPNotEnabled) {
+			// Commit the attempt, the error is returned after the
+			// transaction.
+			failed = err
+			n, err := uc.twoFactor.challenges.AddAttempt(ctx, hash)
+			if err != nil {
+				return err
+			}
+			if n >= maxChallengeAttempts || errors.Is(failed, ErrTOTPNotEnabled) {
+				failed = ErrChallengeInvalid
+				return uc.twoFactor.challenges.Delete(ctx, hash)
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := uc.twoFactor.challenges.Delete(ctx, hash); err != nil {
+			return err
+		}
+		if u, err = uc.users.FindByID(ctx, c.UserID); err != nil {
+			return err
+		}
+		tokens, err = uc.startSession(ctx, c.UserID)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if failed != nil {
+		return nil, nil, failed
+	}
+	return u, tokens, nil
+}
@@ -0,0 +1,73 @@
+package biz
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// The SHA-1 test vectors of RFC 6238 Appendix B, truncated to the last
+// totpDigits digits.
+var rfc6238Vectors = []struct {
+	unix int64
+	code string
+}{
+	{59, "287082"},
+	{1111111109, "081804"},
+	{1111111111, "050471"},
+	{1234567890, "005924"},
+	{2000000000, "279037"},
+	{20000000000, "353130"},
+}
+
+var rfc6238Secret = []byte("12345678901234567890")
+
+func TestTOTPCode(t *testing.T) {
+	for _, v := range rfc6238Vectors {
+		if got := totpCode(rfc6238Secret, v.unix/30); got != v.code {
+			t.Errorf("totpCode() at %d = %s, want %s", v.unix, got, v.code)
+		}
+	}
+}
+
+func TestMatchTOTP(t *testing.T) {
+	for _, v := range rfc6238Vectors {
+		at := time.Unix(v.unix, 0)
+		for _, skew := range []time.Duration{-totpPeriod, 0, totpPeriod} {
+			step, ok := matchTOTP(rfc6238Secret, v.code, at.Add(skew))
+			if !ok || step != v.unix/30 {
+				t.Errorf("matchTOTP(%s) at %d%+v = %d, %t, want %d", v.code, v.unix, skew, step, ok, v.unix/30)
+			}
+		}
+		for _, skew := range []time.Duration{-2 * totpPeriod, 2 * totpPeriod} {
+			if at.Add(skew).Unix() < 0 {
+				// No steps before the epoch.
+				continue
+			}
+			if _, ok := matchTOTP(rfc6238Secret, v.code, at.Add(skew)); ok {
+				t.Errorf("matchTOTP(%s) at %d%+v matched beyond the skew", v.code, v.unix, skew)
+			}
+		}
+	}
+}
+
+func TestNewRecoveryCodes(t *testing.T) {
+	codes, err := newRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := make(map[string]bool)
+	for _, c := range codes {
+		groups := strings.Split(c, "-")
+		if len(groups) != recoveryCodeLen/recoveryCodeGroup || len(strings.Join(groups, "")) != recoveryCodeLen {
+			t.Errorf("recovery code %q is not %d groups of %d characters", c, recoveryCodeLen/recoveryCodeGroup, recoveryCodeGroup)
+		}
+		if seen[c] {
+			t.Errorf("recovery code %q repeats", c)
+		}
+		seen[c] = true
+	}
+	if len(codes) != recoveryCodeCount {
+		t.Errorf("newRecoveryCodes() returned %d codes, want %d", len(codes), recoveryCodeCount)
+	}
+}
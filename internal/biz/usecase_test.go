@@ -0,0 +1,202 @@
+package biz_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"user/internal/biz"
+	"user/internal/conf"
+	"user/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// testApp holds the usecases wired over the in-memory data driver.
+type testApp struct {
+	users        biz.UserRepo
+	user         *biz.UserUsecase
+	graph        *biz.GraphUsecase
+	auth         *biz.AuthUsecase
+	sessions     *biz.Sessions
+	tokens       *biz.TokenManager
+	federation   *biz.FederationUsecase
+	oauth        *biz.OAuthUsecase
+	verification *biz.VerificationUsecase
+	resets       *biz.PasswordResetUsecase
+	resetRepo    biz.PasswordResetRepo
+	totpRepo     biz.TOTPRepo
+	// refreshTokens, followRequests and verifications let tests store what
+	// the usecases would not, such as expired records.
+	refreshTokens  biz.RefreshTokenRepo
+	followRequests biz.FollowRequestRepo
+	verifications  biz.EmailVerificationRepo
+	mailer         *fakeMailer
+}
+
+// fakeMailer hands the mails it is asked to send to the test.
+type fakeMailer struct {
+	sent chan *biz.Mail
+}
+
+func (m *fakeMailer) Send(ctx context.Context, mail *biz.Mail) error {
+	m.sent <- mail
+	return nil
+}
+
+// next returns the next mail with subject, skipping the others.
+func (m *fakeMailer) next(t *testing.T, subject string) *biz.Mail {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case mail := <-m.sent:
+			if mail.Subject == subject {
+				return mail
+			}
+		case <-timeout:
+			t.Fatalf("no mail %q sent", subject)
+		}
+	}
+}
+
+// mailToken returns the token of a mail sent without a link URL.
+func mailToken(t *testing.T, mail *biz.Mail) string {
+	t.Helper()
+	parts := strings.Split(mail.Body, "\n\n")
+	if len(parts) < 3 {
+		t.Fatalf("mail %q has no token", mail.Body)
+	}
+	return parts[2]
+}
+
+// testAdminID is the user id of the OAuth administrator, which no test
+// registers.
+const testAdminID = 1 << 40
+
+// newTestApp wires the usecases over a new in-memory database, with the
+// identity providers ps.
+func newTestApp(t *testing.T, ps biz.IdentityProviders) *testApp {
+	t.Helper()
+	logger := log.DefaultLogger
+	d, cleanup, err := data.NewData(&conf.Data{Database: &conf.Data_Database{Driver: "memory"}}, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	ac := &conf.Auth{
+		Password: &conf.Auth_Password{Algorithm: "bcrypt", BcryptCost: 4},
+		Jwt:      &conf.Auth_JWT{Secret: "test-secret", Issuer: "user"},
+	}
+	mailer := &fakeMailer{sent: make(chan *biz.Mail, 100)}
+	pages, err := biz.NewPaginator(&conf.Pagination{TokenSecret: "test-secret"}, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokens, err := biz.NewTokenManager(ac)
+	if err != nil {
+		t.Fatal(err)
+	}
+	users := data.NewUserRepo(d, logger)
+	refreshTokens := data.NewRefreshTokenRepo(d, logger)
+	creds := data.NewCredentialRepo(d, logger)
+	blocks := data.NewBlockRepo(d, logger)
+	tx := data.NewTransaction(d)
+	handles := biz.NewHandles(&conf.Handles{Reserved: []string{"admin", "Support"}}, data.NewHandleChangeRepo(d, logger))
+	verifications := data.NewEmailVerificationRepo(d, logger)
+	verification := biz.NewVerificationUsecase(ac, verifications, users, mailer, tx, logger)
+	followRequests := data.NewFollowRequestRepo(d, logger)
+	graph := biz.NewGraphUsecase(data.NewGraphRepo(d, logger), followRequests, blocks, data.NewMuteRepo(d, logger), users, tx, pages, logger)
+	sessions := biz.NewSessions(data.NewSessionRepo(d, logger), refreshTokens, data.NewSessionCache(d), tokens, logger)
+	totpRepo := data.NewTOTPRepo(d, logger)
+	twoFactor := biz.NewTwoFactor(ac, totpRepo, data.NewLoginChallengeRepo(d, logger))
+	apiKeys := biz.NewAPIKeys(data.NewAPIKeyRepo(d, logger), logger)
+	hasher := biz.NewPasswordHasher(ac)
+	auth, err := biz.NewAuthUsecase(ac, users, creds, refreshTokens, handles, verification, twoFactor, sessions, apiKeys, tx, hasher, tokens, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oc := &conf.OAuth{Issuer: "https://id.example.com", SigningKeyFile: newSigningKeyFile(t), AdminUserIds: []int64{testAdminID}}
+	oauth, err := biz.NewOAuthUsecase(oc,
+		data.NewOAuthClientRepo(d, logger), data.NewAuthorizationCodeRepo(d, logger), users, auth, sessions, tx, tokens, pages, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resetRepo := data.NewPasswordResetRepo(d, logger)
+	resets := biz.NewPasswordResetUsecase(ac, resetRepo, users, creds, sessions, hasher, mailer, tx, logger)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		resets.HandlePasswordResets(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return &testApp{
+		users:          users,
+		user:           biz.NewUserUsecase(users, blocks, graph, handles, verification, tx, pages, logger),
+		graph:          graph,
+		auth:           auth,
+		sessions:       sessions,
+		tokens:         tokens,
+		federation:     biz.NewFederationUsecase(&conf.Federation{}, ps, data.NewLinkedIdentityRepo(d, logger), data.NewFederatedLoginRepo(d, logger), users, creds, handles, auth, tx, logger),
+		oauth:          oauth,
+		verification:   verification,
+		resets:         resets,
+		resetRepo:      resetRepo,
+		totpRepo:       totpRepo,
+		refreshTokens:  refreshTokens,
+		followRequests: followRequests,
+		verifications:  verifications,
+		mailer:         mailer,
+	}
+}
+
+// newSigningKeyFile writes a new Ed25519 key for ID tokens, which unlike the
+// RSA key made up without one takes no time to generate.
+func newSigningKeyFile(t *testing.T) string {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "key.pem")
+	if err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+// register registers a user with a password, and verifies their email if
+// verified.
+func (a *testApp) register(t *testing.T, username, email string, verified bool) *biz.User {
+	t.Helper()
+	ctx := context.Background()
+	u, err := a.auth.Register(ctx, &biz.User{Username: username, Email: email}, "password123")
+	if err != nil {
+		t.Fatalf("Register(%s) error = %v", username, err)
+	}
+	if verified {
+		if u, err = a.users.VerifyEmail(ctx, u.ID, u.Email); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return u
+}
+
+// as returns a context authenticated as u.
+func as(u *biz.User) context.Context {
+	return biz.NewPrincipalContext(context.Background(), &biz.Principal{UserID: u.ID, SessionID: "test"})
+}
@@ -0,0 +1,283 @@
+package biz
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+	"time"
+
+	v1 "user/api/user/v1"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// maxEmailLen is the longest email of RFC 5321.
+const maxEmailLen = 254
+
+var (
+	// ErrUserNotFound is user not found.
+	ErrUserNotFound = errors.NotFound(v1.ErrorReason_USER_NOT_FOUND.String(), "user not found")
+	// ErrUserAlreadyExists is user already exists.
+	ErrUserAlreadyExists = errors.Conflict(v1.ErrorReason_USER_ALREADY_EXISTS.String(), "user already exists")
+	// ErrEmailTaken is an email used by another user.
+	ErrEmailTaken = errors.Conflict(v1.ErrorReason_EMAIL_TAKEN.String(), "email is already taken")
+	// ErrUsernameTaken is a username used by another user.
+	ErrUsernameTaken = errors.Conflict(v1.ErrorReason_USERNAME_TAKEN.String(), "username is already taken")
+	// ErrInvalidEmail is an email that is not a bare address.
+	ErrInvalidEmail = errors.BadRequest(v1.ErrorReason_INVALID_EMAIL.String(), "email is invalid")
+	// ErrVersionConflict is a write expecting another version of a user than
+	// the stored one.
+	ErrVersionConflict = errors.Conflict(v1.ErrorReason_VERSION_CONFLICT.String(), "user was modified concurrently")
+)
+
+// User is a User model.
+type User struct {
+	ID       int64
+	Username string
+	Email    string
+	// EmailVerified is whether the user proved to own Email. It is reset
+	// when the email changes.
+	EmailVerified bool
+	// Private accounts approve their followers.
+	Private bool
+	Profile Profile
+	// Version starts at 1 and is incremented by every Update. Writes
+	// expecting a version fail with ErrVersionConflict on another one.
+	Version int64
+	// FollowerCount and FollowingCount are denormalized from the follow
+	// graph, and only written by the GraphRepo.
+	FollowerCount  int64
+	FollowingCount int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// UserRepo is a User repo.
+type UserRepo interface {
+	Save(context.Context, *User) (*User, error)
+	// Update writes the fields of a user named by the normalized paths of an
+	// update mask, leaving the others as they are, if the user is at
+	// u.Version, and increments its version.
+	Update(ctx context.Context, u *User, paths []string) (*User, error)
+	// Delete removes a user at version, or at any version if it is 0.
+	Delete(ctx context.Context, id int64, version int64) error
+	FindByID(context.Context, int64) (*User, error)
+	FindByEmail(context.Context, string) (*User, error)
+	// FindByHandle returns the user whose handle has the given HandleKey.
+	FindByHandle(ctx context.Context, key string) (*User, error)
+	// VerifyEmail marks the email of id as verified if it still is email,
+	// and returns ErrUserNotFound otherwise.
+	VerifyEmail(ctx context.Context, id int64, email string) (*User, error)
+	// FindByIDs returns the users with the given ids in any order, skipping
+	// the ids without one.
+	FindByIDs(ctx context.Context, ids []int64) ([]*User, error)
+	// FindByHandles returns the users whose handles have the given
+	// HandleKeys in any order, skipping the keys without one.
+	FindByHandles(ctx context.Context, keys []string) ([]*User, error)
+	// List returns a page of users ordered by id.
+	List(context.Context, *Page) ([]*User, error)
+}
+
+// UserUsecase is a User usecase.
+type UserUsecase struct {
+	repo    UserRepo
+	blocks  BlockRepo
+	graph   *GraphUsecase
+	handles *Handles
+	// verification is sent the changed emails.
+	verification *VerificationUsecase
+	tx           Transaction
+	pages        *Paginator
+	log          *log.Helper
+}
+
+// NewUserUsecase new a User usecase.
+func NewUserUsecase(repo UserRepo, blocks BlockRepo, graph *GraphUsecase, handles *Handles, verification *VerificationUsecase, tx Transaction, pages *Paginator, logger log.Logger) *UserUsecase {
+	return &UserUsecase{
+		repo:         repo,
+		blocks:       blocks,
+		graph:        graph,
+		handles:      handles,
+		verification: verification,
+		tx:           tx,
+		pages:        pages,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// GetUser returns the User with the given id, unless they block the
+// caller.
+func (uc *UserUsecase) GetUser(ctx context.Context, id int64) (*User, error) {
+	u, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkViewable(ctx, uc.blocks, id); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// GetUserByHandle returns the User with the given handle, unless they block
+// the caller. A handle given up within its grace period resolves to its
+// former user, and redirected reports it.
+func (uc *UserUsecase) GetUserByHandle(ctx context.Context, handle string) (u *User, redirected bool, err error) {
+	key := HandleKey(handle)
+	u, err = uc.repo.FindByHandle(ctx, key)
+	if errors.Is(err, ErrUserNotFound) {
+		var c *HandleChange
+		c, err = uc.handles.repo.Find(ctx, key, time.Now())
+		if errors.Is(err, ErrHandleNotFound) {
+			return nil, false, ErrUserNotFound
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		u, err = uc.repo.FindByID(ctx, c.UserID)
+		redirected = true
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if err := checkViewable(ctx, uc.blocks, u.ID); err != nil {
+		return nil, false, err
+	}
+	return u, redirected, nil
+}
+
+// ChangeHandle changes the handle of the User with the given id, and returns
+// the updated User. The old handle keeps resolving to the user for the grace
+// period, and users may only change handles once per change interval.
+func (uc *UserUsecase) ChangeHandle(ctx context.Context, id int64, handle string) (*User, error) {
+	return uc.UpdateUser(ctx, &User{ID: id, Username: handle}, []string{PathUsername})
+}
+
+// UpdateUser updates the fields of a User named by the paths of an update
+// mask, and returns the updated User. A non-zero u.Version is the version the
+// update expects. Changing the username follows the rules of ChangeHandle.
+// An account turning public approves its pending follow requests. API keys
+// and OAuth clients may not change the email.
+func (uc *UserUsecase) UpdateUser(ctx context.Context, u *User, paths []string) (*User, error) {
+	uc.log.WithContext(ctx).Infof("UpdateUser: %d %v", u.ID, paths)
+	if err := authorize(ctx, u.ID); err != nil {
+		return nil, err
+	}
+	mask, err := normalizeUserMask(paths)
+	if err != nil {
+		return nil, err
+	}
+	if contains(mask, PathEmail) {
+		if err := authorizeAccount(ctx, u.ID); err != nil {
+			return nil, err
+		}
+		u.Email = normalizeEmail(u.Email)
+		if err := validateEmail(u.Email); err != nil {
+			return nil, err
+		}
+	}
+	changesHandle := mask[0] == PathUsername
+	if changesHandle {
+		if u.Username, err = uc.handles.normalize(u.Username); err != nil {
+			return nil, err
+		}
+	}
+	var (
+		nu           *User
+		emailChanged bool
+	)
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		old, err := uc.repo.FindByID(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+		if u.Version != 0 && u.Version != old.Version {
+			return ErrVersionConflict
+		}
+		if changesHandle {
+			if err := uc.handles.change(ctx, old, u.Username); err != nil {
+				return err
+			}
+		}
+		merged := *old
+		CopyUserFields(&merged, u, mask)
+		merged.Email = normalizeEmail(merged.Email)
+		emailChanged = merged.Email != old.Email
+		if emailChanged {
+			merged.EmailVerified = false
+		}
+		if err := merged.Profile.normalize(); err != nil {
+			return err
+		}
+		if nu, err = uc.repo.Update(ctx, &merged, mask); err != nil {
+			return err
+		}
+		if old.Private && !nu.Private {
+			if err := uc.graph.approveAllFollowRequests(ctx, u.ID); err != nil {
+				return err
+			}
+			nu, err = uc.repo.FindByID(ctx, u.ID)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if emailChanged {
+		uc.verification.sendVerification(ctx, nu)
+	}
+	return nu, nil
+}
+
+// DeleteUser deletes the User with the given id, at version unless it is 0.
+// API keys and OAuth clients may not delete their user.
+func (uc *UserUsecase) DeleteUser(ctx context.Context, id int64, version int64) error {
+	uc.log.WithContext(ctx).Infof("DeleteUser: %d", id)
+	if err := authorizeAccount(ctx, id); err != nil {
+		return err
+	}
+	return uc.repo.Delete(ctx, id, version)
+}
+
+// ListUsers lists a page of Users, and returns the token of the next page.
+// Users blocking the caller are left out, so pages may come out short.
+func (uc *UserUsecase) ListUsers(ctx context.Context, req PageRequest) ([]*User, string, error) {
+	const scope = "users"
+	page, err := uc.pages.Page(scope, req)
+	if err != nil {
+		return nil, "", err
+	}
+	us, err := uc.repo.List(ctx, page)
+	if err != nil {
+		return nil, "", err
+	}
+	n, next := uc.pages.Next(scope, page, len(us), func(i int) Cursor { return Cursor{ID: us[i].ID} })
+	byID, err := uc.visibleUsers(ctx, us[:n])
+	if err != nil {
+		return nil, "", err
+	}
+	visible := make([]*User, 0, len(byID))
+	for _, u := range us[:n] {
+		if byID[u.ID] != nil {
+			visible = append(visible, u)
+		}
+	}
+	return visible, next, nil
+}
+
+// normalizeEmail lower-cases email so that lookups are case-insensitive.
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// validateEmail checks that a normalized email is a bare address, such as
+// "jane@example.com", which is all the repos store.
+func validateEmail(email string) error {
+	if len(email) > maxEmailLen {
+		return ErrInvalidEmail
+	}
+	a, err := mail.ParseAddress(email)
+	if err != nil || a.Address != email || a.Name != "" {
+		return ErrInvalidEmail
+	}
+	return nil
+}
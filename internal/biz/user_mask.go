@@ -0,0 +1,116 @@
+package biz
+
+import (
+	"strings"
+
+	v1 "user/api/user/v1"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// The paths of the updatable User fields, as in the update mask of the API.
+const (
+	PathUsername           = "username"
+	PathEmail              = "email"
+	PathPrivate            = "private"
+	PathProfile            = "profile"
+	PathProfileDisplayName = "profile.display_name"
+	PathProfileBio         = "profile.bio"
+	PathProfileAvatarURL   = "profile.avatar_url"
+	PathProfileLocation    = "profile.location"
+	PathProfileWebsite     = "profile.website"
+	PathProfileBirthDate   = "profile.birth_date"
+	PathProfileLocale      = "profile.locale"
+	PathProfileTimezone    = "profile.timezone"
+)
+
+// userPaths are the paths of the updatable User fields, in their canonical
+// order. PathProfile stands for every path of the profile.
+var userPaths = []string{
+	PathUsername,
+	PathEmail,
+	PathPrivate,
+	PathProfileDisplayName,
+	PathProfileBio,
+	PathProfileAvatarURL,
+	PathProfileLocation,
+	PathProfileWebsite,
+	PathProfileBirthDate,
+	PathProfileLocale,
+	PathProfileTimezone,
+}
+
+// invalidUpdateMask is the error of an update mask that cannot be applied.
+func invalidUpdateMask(path, msg string) error {
+	return errors.BadRequest(v1.ErrorReason_INVALID_UPDATE_MASK.String(), msg).
+		WithMetadata(map[string]string{"path": path})
+}
+
+// normalizeUserMask checks the paths of an update mask, and returns them
+// without duplicates, PathProfile expanded, in their canonical order.
+func normalizeUserMask(paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		return nil, invalidUpdateMask("", "update mask is empty")
+	}
+	set := make(map[string]bool, len(paths))
+	for _, p := range paths {
+		if p == PathProfile {
+			for _, pp := range userPaths {
+				if strings.HasPrefix(pp, PathProfile+".") {
+					set[pp] = true
+				}
+			}
+			continue
+		}
+		if !isUserPath(p) {
+			return nil, invalidUpdateMask(p, "unknown update mask path "+p)
+		}
+		set[p] = true
+	}
+	mask := make([]string, 0, len(set))
+	for _, p := range userPaths {
+		if set[p] {
+			mask = append(mask, p)
+		}
+	}
+	return mask, nil
+}
+
+func isUserPath(path string) bool {
+	for _, p := range userPaths {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+// CopyUserFields copies the fields of src named by the normalized paths of
+// an update mask to dst.
+func CopyUserFields(dst, src *User, paths []string) {
+	for _, p := range paths {
+		switch p {
+		case PathUsername:
+			dst.Username = src.Username
+		case PathEmail:
+			dst.Email = src.Email
+		case PathPrivate:
+			dst.Private = src.Private
+		case PathProfileDisplayName:
+			dst.Profile.DisplayName = src.Profile.DisplayName
+		case PathProfileBio:
+			dst.Profile.Bio = src.Profile.Bio
+		case PathProfileAvatarURL:
+			dst.Profile.AvatarURL = src.Profile.AvatarURL
+		case PathProfileLocation:
+			dst.Profile.Location = src.Profile.Location
+		case PathProfileWebsite:
+			dst.Profile.Website = src.Profile.Website
+		case PathProfileBirthDate:
+			dst.Profile.BirthDate = src.Profile.BirthDate
+		case PathProfileLocale:
+			dst.Profile.Locale = src.Profile.Locale
+		case PathProfileTimezone:
+			dst.Profile.Timezone = src.Profile.Timezone
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+
+	v1 "user/api/user/v1"
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestUpdateUserMask(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	ctx := as(jane)
+
+	u, err := a.user.UpdateUser(ctx, &biz.User{ID: jane.ID, Profile: biz.Profile{DisplayName: "Jane", Bio: "hi"}}, []string{biz.PathProfileDisplayName, biz.PathProfileBio})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+	if u.Profile.DisplayName != "Jane" || u.Profile.Bio != "hi" || u.Username != "jane" || u.Email != jane.Email {
+		t.Errorf("UpdateUser() = %+v, want only the display name and bio changed", u)
+	}
+	u, err = a.user.UpdateUser(ctx, &biz.User{ID: jane.ID, Profile: biz.Profile{Bio: "hello"}}, []string{biz.PathProfile})
+	if err != nil || u.Profile.Bio != "hello" || u.Profile.DisplayName != "" {
+		t.Errorf("UpdateUser() of the whole profile = %+v, %v, want the display name cleared", u.Profile, err)
+	}
+	for _, paths := range [][]string{nil, {"password"}, {"profile.unknown"}} {
+		_, err := a.user.UpdateUser(ctx, &biz.User{ID: jane.ID}, paths)
+		if e := errors.FromError(err); e.Reason != v1.ErrorReason_INVALID_UPDATE_MASK.String() {
+			t.Errorf("UpdateUser(%v) = %v, want INVALID_UPDATE_MASK", paths, err)
+		}
+	}
+}
+
+func TestUpdateUserDelegated(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", true)
+	for name, p := range map[string]*biz.Principal{
+		"api key":      {UserID: jane.ID, APIKeyID: 1, Scope: biz.ScopeUsersWrite},
+		"oauth client": {UserID: jane.ID, SessionID: "s", ClientID: "client", Scope: biz.ScopeUsersWrite},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := biz.NewPrincipalContext(context.Background(), p)
+			u, err := a.user.UpdateUser(ctx, &biz.User{ID: jane.ID, Profile: biz.Profile{Bio: name}}, []string{biz.PathProfileBio})
+			if err != nil || u.Profile.Bio != name {
+				t.Errorf("UpdateUser() of the bio = %+v, %v", u, err)
+			}
+			if _, err := a.user.UpdateUser(ctx, &biz.User{ID: jane.ID, Email: "other@example.com"}, []string{biz.PathEmail}); !errors.Is(err, biz.ErrPermissionDenied) {
+				t.Errorf("UpdateUser() of the email = %v, want ErrPermissionDenied", err)
+			}
+			if err := a.user.DeleteUser(ctx, jane.ID, 0); !errors.Is(err, biz.ErrPermissionDenied) {
+				t.Errorf("DeleteUser() = %v, want ErrPermissionDenied", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package biz
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateEmail(t *testing.T) {
+	valid := []string{"jane@example.com", "j.doe+tag@mail.example.co.uk", "x@localhost"}
+	invalid := []string{
+		"",
+		"not an email",
+		"jane",
+		"jane@",
+		"@example.com",
+		"Jane <jane@example.com>",
+		"jane@example.com, joe@example.com",
+		strings.Repeat("a", 250) + "@x.io",
+	}
+	for _, email := range valid {
+		if err := validateEmail(email); err != nil {
+			t.Errorf("validateEmail(%q) = %v", email, err)
+		}
+	}
+	for _, email := range invalid {
+		if err := validateEmail(email); err == nil {
+			t.Errorf("validateEmail(%q) = nil", email)
+		}
+	}
+}
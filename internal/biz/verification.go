@@ -0,0 +1,210 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	v1 "user/api/user/v1"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	verificationTokenBytes = 32
+
+	defaultVerificationTokenTTL       = 24 * time.Hour
+	defaultVerificationResendInterval = time.Minute
+)
+
+var (
+	// ErrVerificationTokenInvalid is a verification token that is unknown,
+	// used, expired or for an email the user no longer has.
+	ErrVerificationTokenInvalid = errors.BadRequest(v1.ErrorReason_VERIFICATION_TOKEN_INVALID.String(), "verification token is invalid")
+	// ErrEmailAlreadyVerified is a verification of a verified email.
+	ErrEmailAlreadyVerified = errors.Conflict(v1.ErrorReason_EMAIL_ALREADY_VERIFIED.String(), "email is already verified")
+	// ErrVerificationTooSoon is a verification resent before the end of the
+	// resend interval.
+	ErrVerificationTooSoon = errors.New(http.StatusTooManyRequests, v1.ErrorReason_VERIFICATION_TOO_SOON.String(),
+		"verification was sent too recently")
+)
+
+// Mail is a plain text email.
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer sends emails.
+type Mailer interface {
+	Send(context.Context, *Mail) error
+}
+
+// EmailVerification is the pending verification of the email of a user.
+// Only the hash of its token is stored, and a user has at most one.
+type EmailVerification struct {
+	UserID    int64
+	TokenHash string
+	Email     string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// EmailVerificationRepo is an EmailVerification repo.
+type EmailVerificationRepo interface {
+	// Save stores a verification, replacing the one of the user.
+	Save(context.Context, *EmailVerification) (*EmailVerification, error)
+	// Find returns the verification of userID, or
+	// ErrVerificationTokenInvalid.
+	Find(ctx context.Context, userID int64) (*EmailVerification, error)
+	// FindByTokenHash returns the verification of a token hash, or
+	// ErrVerificationTokenInvalid.
+	FindByTokenHash(ctx context.Context, hash string) (*EmailVerification, error)
+	Delete(ctx context.Context, userID int64) error
+}
+
+// VerificationUsecase verifies that users own their email.
+type VerificationUsecase struct {
+	repo           EmailVerificationRepo
+	users          UserRepo
+	mailer         Mailer
+	tx             Transaction
+	tokenTTL       time.Duration
+	resendInterval time.Duration
+	url            string
+	log            *log.Helper
+}
+
+// NewVerificationUsecase new a VerificationUsecase.
+func NewVerificationUsecase(c *conf.Auth, repo EmailVerificationRepo, users UserRepo, mailer Mailer, tx Transaction, logger log.Logger) *VerificationUsecase {
+	uc := &VerificationUsecase{
+		repo:           repo,
+		users:          users,
+		mailer:         mailer,
+		tx:             tx,
+		tokenTTL:       defaultVerificationTokenTTL,
+		resendInterval: defaultVerificationResendInterval,
+		url:            c.GetEmailVerification().GetUrl(),
+		log:            log.NewHelper(logger),
+	}
+	if ttl := c.GetEmailVerification().GetTokenTtl(); ttl != nil {
+		uc.tokenTTL = ttl.AsDuration()
+	}
+	if d := c.GetEmailVerification().GetResendInterval(); d != nil {
+		uc.resendInterval = d.AsDuration()
+	}
+	return uc
+}
+
+// VerifyEmail consumes a verification token and marks the email it was sent
+// to as verified, and returns the updated User. A token is spent even when
+// it has expired or the user has changed email since.
+func (uc *VerificationUsecase) VerifyEmail(ctx context.Context, token string) (*User, error) {
+	var u *User
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		v, err := uc.repo.FindByTokenHash(ctx, hashToken(token))
+		if err != nil {
+			return err
+		}
+		if err := uc.repo.Delete(ctx, v.UserID); err != nil {
+			return err
+		}
+		if !v.ExpiresAt.After(time.Now()) {
+			return nil
+		}
+		u, err = uc.users.VerifyEmail(ctx, v.UserID, v.Email)
+		if errors.Is(err, ErrUserNotFound) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		return nil, ErrVerificationTokenInvalid
+	}
+	uc.log.WithContext(ctx).Infof("VerifyEmail: %d", u.ID)
+	return u, nil
+}
+
+// ResendVerification sends a new verification token to the email of the
+// authenticated user, which invalidates the previous one.
+func (uc *VerificationUsecase) ResendVerification(ctx context.Context) error {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	u, err := uc.users.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if u.EmailVerified {
+		return ErrEmailAlreadyVerified
+	}
+	v, err := uc.repo.Find(ctx, userID)
+	switch {
+	case errors.Is(err, ErrVerificationTokenInvalid):
+	case err != nil:
+		return err
+	case time.Since(v.CreatedAt) < uc.resendInterval:
+		return ErrVerificationTooSoon
+	}
+	return uc.send(ctx, u)
+}
+
+// sendVerification sends a verification token to the unverified email of u,
+// logging rather than returning failures, since the user can ask to resend
+// it. Callers run it after committing the email.
+func (uc *VerificationUsecase) sendVerification(ctx context.Context, u *User) {
+	if u.EmailVerified {
+		return
+	}
+	if err := uc.send(ctx, u); err != nil {
+		uc.log.WithContext(ctx).Errorf("send verification to user %d: %v", u.ID, err)
+	}
+}
+
+func (uc *VerificationUsecase) send(ctx context.Context, u *User) error {
+	token, err := randomToken(verificationTokenBytes)
+	if err != nil {
+		return err
+	}
+	_, err = uc.repo.Save(ctx, &EmailVerification{
+		UserID:    u.ID,
+		TokenHash: hashToken(token),
+		Email:     u.Email,
+		ExpiresAt: time.Now().Add(uc.tokenTTL),
+	})
+	if err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("sending verification to user %d", u.ID)
+	return uc.mailer.Send(ctx, &Mail{
+		To:      u.Email,
+		Subject: "Verify your email",
+		Body:    uc.verificationBody(u, token),
+	})
+}
+
+func (uc *VerificationUsecase) verificationBody(u *User, token string) string {
+	return fmt.Sprintf("Hi %s,\n\nConfirm that %s is your email with:\n\n%s\n\nIt expires in %s. If you did not sign up, ignore this email.\n",
+		u.Username, u.Email, tokenLink(uc.url, token), uc.tokenTTL)
+}
+
+// tokenLink returns the link of a mailed token: base with the token query
+// parameter, or the token alone without a valid base.
+func tokenLink(base, token string) string {
+	l, err := url.Parse(base)
+	if err != nil || base == "" {
+		return token
+	}
+	q := l.Query()
+	q.Set("token", token)
+	l.RawQuery = q.Encode()
+	return l.String()
+}
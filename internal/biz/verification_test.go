@@ -0,0 +1,95 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const verificationSubject = "Verify your email"
+
+// verificationToken returns the token of the next verification mailed, and
+// checks it was mailed to email.
+func verificationToken(t *testing.T, a *testApp, email string) string {
+	t.Helper()
+	mail := a.mailer.next(t, verificationSubject)
+	if mail.To != email {
+		t.Fatalf("verification mailed to %s, want %s", mail.To, email)
+	}
+	return mailToken(t, mail)
+}
+
+func TestVerifyEmail(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", false)
+	token := verificationToken(t, a, jane.Email)
+	ctx := context.Background()
+
+	if err := a.verification.ResendVerification(as(jane)); !errors.Is(err, biz.ErrVerificationTooSoon) {
+		t.Errorf("ResendVerification() right after registering = %v, want ErrVerificationTooSoon", err)
+	}
+	u, err := a.verification.VerifyEmail(ctx, token)
+	if err != nil {
+		t.Fatalf("VerifyEmail() error = %v", err)
+	}
+	if u.ID != jane.ID || !u.EmailVerified {
+		t.Errorf("VerifyEmail() = %+v, want jane verified", u)
+	}
+	if _, err := a.verification.VerifyEmail(ctx, token); !errors.Is(err, biz.ErrVerificationTokenInvalid) {
+		t.Errorf("VerifyEmail() with a used token = %v, want ErrVerificationTokenInvalid", err)
+	}
+	if err := a.verification.ResendVerification(as(jane)); !errors.Is(err, biz.ErrEmailAlreadyVerified) {
+		t.Errorf("ResendVerification() of a verified email = %v, want ErrEmailAlreadyVerified", err)
+	}
+}
+
+func TestVerifyEmailExpired(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", false)
+	verificationToken(t, a, jane.Email)
+	ctx := context.Background()
+	_, err := a.verifications.Save(ctx, &biz.EmailVerification{
+		UserID:    jane.ID,
+		TokenHash: biz.HashToken("expired"),
+		Email:     jane.Email,
+		ExpiresAt: time.Now().Add(-time.Second),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := a.verification.VerifyEmail(ctx, "expired"); !errors.Is(err, biz.ErrVerificationTokenInvalid) {
+		t.Errorf("VerifyEmail() with an expired token = %v, want ErrVerificationTokenInvalid", err)
+	}
+	if u, err := a.users.FindByID(ctx, jane.ID); err != nil || u.EmailVerified {
+		t.Errorf("email verified after an expired token = %t, %v", u.EmailVerified, err)
+	}
+	// The expired token was spent, so a new one may be sent right away.
+	if err := a.verification.ResendVerification(as(jane)); err != nil {
+		t.Fatalf("ResendVerification() after an expired token = %v", err)
+	}
+	if _, err := a.verification.VerifyEmail(ctx, verificationToken(t, a, jane.Email)); err != nil {
+		t.Errorf("VerifyEmail() with the resent token = %v", err)
+	}
+}
+
+func TestVerifyEmailAfterEmailChange(t *testing.T) {
+	a := newTestApp(t, nil)
+	jane := a.register(t, "jane", "jane@example.com", false)
+	old := verificationToken(t, a, jane.Email)
+	ctx := context.Background()
+	if _, err := a.user.UpdateUser(as(jane), &biz.User{ID: jane.ID, Email: "jane@example.org"}, []string{biz.PathEmail}); err != nil {
+		t.Fatal(err)
+	}
+	token := verificationToken(t, a, "jane@example.org")
+
+	if _, err := a.verification.VerifyEmail(ctx, old); !errors.Is(err, biz.ErrVerificationTokenInvalid) {
+		t.Errorf("VerifyEmail() with the token of the old email = %v, want ErrVerificationTokenInvalid", err)
+	}
+	if u, err := a.verification.VerifyEmail(ctx, token); err != nil || !u.EmailVerified || u.Email != "jane@example.org" {
+		t.Errorf("VerifyEmail() of the new email = %+v, %v", u, err)
+	}
+}
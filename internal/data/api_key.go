@@ -0,0 +1,119 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const apiKeyColumns = "id, user_id, name, prefix, key_hash, scopes, created_at, expires_at, last_used_at"
+
+type apiKeyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAPIKeyRepo .
+func NewAPIKeyRepo(data *Data, logger log.Logger) biz.APIKeyRepo {
+	if data.mem != nil {
+		return &memoryAPIKeyRepo{data: data}
+	}
+	return &apiKeyRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *apiKeyRepo) Save(ctx context.Context, k *biz.APIKey) (*biz.APIKey, error) {
+	nk := newAPIKey(k)
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"INSERT INTO api_keys (user_id, name, prefix, key_hash, scopes, created_at, expires_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		nk.UserID, nk.Name, nk.Prefix, nk.KeyHash, strings.Join(nk.Scopes, " "), nk.CreatedAt, nk.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if nk.ID, err = res.LastInsertId(); err != nil {
+		return nil, err
+	}
+	return nk, nil
+}
+
+func (r *apiKeyRepo) FindByPrefix(ctx context.Context, prefix string) (*biz.APIKey, error) {
+	k, err := scanAPIKey(r.data.conn(ctx).QueryRowContext(ctx,
+		"SELECT "+apiKeyColumns+" FROM api_keys WHERE prefix = ?", prefix))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrAPIKeyNotFound
+	}
+	return k, err
+}
+
+func (r *apiKeyRepo) List(ctx context.Context, userID int64) ([]*biz.APIKey, error) {
+	rows, err := r.data.conn(ctx).QueryContext(ctx,
+		"SELECT "+apiKeyColumns+" FROM api_keys WHERE user_id = ? ORDER BY id", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ks []*biz.APIKey
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		ks = append(ks, k)
+	}
+	return ks, rows.Err()
+}
+
+func (r *apiKeyRepo) Touch(ctx context.Context, id int64, at time.Time) error {
+	_, err := r.data.conn(ctx).ExecContext(ctx,
+		"UPDATE api_keys SET last_used_at = ? WHERE id = ?", at.UTC().Truncate(time.Microsecond), id)
+	return err
+}
+
+func (r *apiKeyRepo) Delete(ctx context.Context, userID, id int64) error {
+	res, err := r.data.conn(ctx).ExecContext(ctx, "DELETE FROM api_keys WHERE id = ? AND user_id = ?", id, userID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return biz.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// scanAPIKey scans a key, whose scopes are stored space separated.
+func scanAPIKey(row scanner) (*biz.APIKey, error) {
+	var (
+		k                 biz.APIKey
+		scopes            string
+		expires, lastUsed sql.NullTime
+	)
+	err := row.Scan(&k.ID, &k.UserID, &k.Name, &k.Prefix, &k.KeyHash, &scopes, &k.CreatedAt, &expires, &lastUsed)
+	if err != nil {
+		return nil, err
+	}
+	k.Scopes = strings.Fields(scopes)
+	k.ExpiresAt, k.LastUsedAt = nullTime(expires), nullTime(lastUsed)
+	return &k, nil
+}
+
+// newAPIKey returns the row of a new key.
+func newAPIKey(k *biz.APIKey) *biz.APIKey {
+	nk := *k
+	nk.Scopes = append([]string(nil), k.Scopes...)
+	nk.CreatedAt = now()
+	if k.ExpiresAt != nil {
+		t := k.ExpiresAt.UTC().Truncate(time.Microsecond)
+		nk.ExpiresAt = &t
+	}
+	nk.LastUsedAt = nil
+	return &nk
+}
@@ -0,0 +1,81 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"user/internal/biz"
+)
+
+// memoryAPIKeyRepo is the biz.APIKeyRepo of the memory driver.
+type memoryAPIKeyRepo struct {
+	data *Data
+}
+
+func (r *memoryAPIKeyRepo) Save(ctx context.Context, k *biz.APIKey) (*biz.APIKey, error) {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	if _, ok := m.users[k.UserID]; !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	nk := newAPIKey(k)
+	nk.ID = m.nextID("api_keys")
+	m.apiKeys[nk.ID] = nk
+	return copyAPIKey(nk), nil
+}
+
+func (r *memoryAPIKeyRepo) FindByPrefix(ctx context.Context, prefix string) (*biz.APIKey, error) {
+	m := r.data.mem
+	defer m.rlock(ctx)()
+	for _, k := range m.apiKeys {
+		if k.Prefix == prefix {
+			return copyAPIKey(k), nil
+		}
+	}
+	return nil, biz.ErrAPIKeyNotFound
+}
+
+func (r *memoryAPIKeyRepo) List(ctx context.Context, userID int64) ([]*biz.APIKey, error) {
+	m := r.data.mem
+	defer m.rlock(ctx)()
+	var ks []*biz.APIKey
+	for _, k := range m.apiKeys {
+		if k.UserID == userID {
+			ks = append(ks, copyAPIKey(k))
+		}
+	}
+	sort.Slice(ks, func(i, j int) bool { return ks[i].ID < ks[j].ID })
+	return ks, nil
+}
+
+func (r *memoryAPIKeyRepo) Touch(ctx context.Context, id int64, at time.Time) error {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	k, ok := m.apiKeys[id]
+	if !ok {
+		return nil
+	}
+	nk := copyAPIKey(k)
+	at = at.UTC().Truncate(time.Microsecond)
+	nk.LastUsedAt = &at
+	m.apiKeys[id] = nk
+	return nil
+}
+
+func (r *memoryAPIKeyRepo) Delete(ctx context.Context, userID, id int64) error {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	k, ok := m.apiKeys[id]
+	if !ok || k.UserID != userID {
+		return biz.ErrAPIKeyNotFound
+	}
+	delete(m.apiKeys, id)
+	return nil
+}
+
+func copyAPIKey(k *biz.APIKey) *biz.APIKey {
+	nk := *k
+	nk.Scopes = append([]string(nil), k.Scopes...)
+	return &nk
+}
@@ -0,0 +1,81 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const authorizationCodeColumns = "code_hash, client_id, user_id, redirect_uri, scope, nonce, code_challenge, created_at, expires_at, used_at, session_id"
+
+type authorizationCodeRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAuthorizationCodeRepo .
+func NewAuthorizationCodeRepo(data *Data, logger log.Logger) biz.AuthorizationCodeRepo {
+	if data.mem != nil {
+		return &memoryAuthorizationCodeRepo{data: data}
+	}
+	return &authorizationCodeRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *authorizationCodeRepo) Save(ctx context.Context, c *biz.AuthorizationCode) error {
+	nc := newAuthorizationCode(c)
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		if _, err := r.data.conn(ctx).ExecContext(ctx, "DELETE FROM oauth_authorization_codes WHERE expires_at <= ?", nc.CreatedAt); err != nil {
+			return err
+		}
+		_, err := r.data.conn(ctx).ExecContext(ctx,
+			"INSERT INTO oauth_authorization_codes ("+authorizationCodeColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, NULL, '')",
+			nc.CodeHash, nc.ClientID, nc.UserID, nc.RedirectURI, nc.Scope, nc.Nonce, nc.CodeChallenge, nc.CreatedAt, nc.ExpiresAt)
+		return err
+	})
+}
+
+func (r *authorizationCodeRepo) FindByHash(ctx context.Context, hash string) (*biz.AuthorizationCode, error) {
+	var (
+		c    biz.AuthorizationCode
+		used sql.NullTime
+	)
+	err := r.data.conn(ctx).QueryRowContext(ctx,
+		"SELECT "+authorizationCodeColumns+" FROM oauth_authorization_codes WHERE code_hash = ?", hash).
+		Scan(&c.CodeHash, &c.ClientID, &c.UserID, &c.RedirectURI, &c.Scope, &c.Nonce, &c.CodeChallenge, &c.CreatedAt, &c.ExpiresAt, &used, &c.SessionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrAuthorizationCodeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.UsedAt = nullTime(used)
+	return &c, nil
+}
+
+func (r *authorizationCodeRepo) Use(ctx context.Context, hash, sessionID string, at time.Time) (bool, error) {
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"UPDATE oauth_authorization_codes SET used_at = ?, session_id = ? WHERE code_hash = ? AND used_at IS NULL",
+		at.UTC(), sessionID, hash)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
+
+// newAuthorizationCode returns the row of a new code.
+func newAuthorizationCode(c *biz.AuthorizationCode) *biz.AuthorizationCode {
+	nc := *c
+	nc.CreatedAt = now()
+	nc.ExpiresAt = c.ExpiresAt.UTC().Truncate(time.Microsecond)
+	nc.UsedAt = nil
+	nc.SessionID = ""
+	return &nc
+}
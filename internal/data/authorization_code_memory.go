@@ -0,0 +1,59 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"user/internal/biz"
+)
+
+// memoryAuthorizationCodeRepo is the biz.AuthorizationCodeRepo of the memory
+// driver.
+type memoryAuthorizationCodeRepo struct {
+	data *Data
+}
+
+func (r *memoryAuthorizationCodeRepo) Save(ctx context.Context, c *biz.AuthorizationCode) error {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	if _, ok := m.users[c.UserID]; !ok {
+		return biz.ErrUserNotFound
+	}
+	if _, ok := m.oauthClients[c.ClientID]; !ok {
+		return biz.ErrOAuthClientNotFound
+	}
+	nc := newAuthorizationCode(c)
+	for k, o := range m.authorizationCodes {
+		if !o.ExpiresAt.After(nc.CreatedAt) {
+			delete(m.authorizationCodes, k)
+		}
+	}
+	m.authorizationCodes[nc.CodeHash] = nc
+	return nil
+}
+
+func (r *memoryAuthorizationCodeRepo) FindByHash(ctx context.Context, hash string) (*biz.AuthorizationCode, error) {
+	m := r.data.mem
+	defer m.rlock(ctx)()
+	c, ok := m.authorizationCodes[hash]
+	if !ok {
+		return nil, biz.ErrAuthorizationCodeNotFound
+	}
+	nc := *c
+	return &nc, nil
+}
+
+func (r *memoryAuthorizationCodeRepo) Use(ctx context.Context, hash, sessionID string, at time.Time) (bool, error) {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	c, ok := m.authorizationCodes[hash]
+	if !ok || c.UsedAt != nil {
+		return false, nil
+	}
+	nc := *c
+	at = at.UTC()
+	nc.UsedAt = &at
+	nc.SessionID = sessionID
+	m.authorizationCodes[hash] = &nc
+	return true, nil
+}
@@ -0,0 +1,85 @@
+package data
+
+import (
+	"context"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type blockRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewBlockRepo .
+func NewBlockRepo(data *Data, logger log.Logger) biz.BlockRepo {
+	if data.mem != nil {
+		return &memoryBlockRepo{data: data}
+	}
+	return &blockRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *blockRepo) Save(ctx context.Context, b *biz.Block) (*biz.Block, error) {
+	t := now()
+	_, err := r.data.conn(ctx).ExecContext(ctx,
+		"INSERT INTO blocks (blocker_id, blocked_id, created_at) VALUES (?, ?, ?)",
+		b.BlockerID, b.BlockedID, t)
+	if isDuplicate(err) {
+		return nil, biz.ErrAlreadyBlocked
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &biz.Block{BlockerID: b.BlockerID, BlockedID: b.BlockedID, CreatedAt: t}, nil
+}
+
+func (r *blockRepo) Delete(ctx context.Context, blockerID, blockedID int64) error {
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"DELETE FROM blocks WHERE blocker_id = ? AND blocked_id = ?", blockerID, blockedID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return biz.ErrNotBlocked
+	}
+	return nil
+}
+
+func (r *blockRepo) Blockers(ctx context.Context, blockedID int64, blockerIDs []int64) ([]int64, error) {
+	if len(blockerIDs) == 0 {
+		return nil, nil
+	}
+	args := make([]interface{}, 0, len(blockerIDs)+1)
+	args = append(args, blockedID)
+	for _, id := range blockerIDs {
+		args = append(args, id)
+	}
+	rows, err := r.data.conn(ctx).QueryContext(ctx,
+		"SELECT blocker_id FROM blocks WHERE blocked_id = ? AND blocker_id IN ("+placeholders(len(blockerIDs))+")", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (r *blockRepo) Exists(ctx context.Context, blockerID, blockedID int64) (bool, error) {
+	var n int
+	err := r.data.conn(ctx).QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM blocks WHERE blocker_id = ? AND blocked_id = ?", blockerID, blockedID).Scan(&n)
+	return n > 0, err
+}
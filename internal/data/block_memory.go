@@ -0,0 +1,61 @@
+package data
+
+import (
+	"context"
+
+	"user/internal/biz"
+)
+
+// memoryBlockRepo is the biz.BlockRepo of the memory driver.
+type memoryBlockRepo struct {
+	data *Data
+}
+
+func (r *memoryBlockRepo) Save(ctx context.Context, b *biz.Block) (*biz.Block, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	if _, ok := s.users[b.BlockerID]; !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	if _, ok := s.users[b.BlockedID]; !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	k := edgeKey{b.BlockerID, b.BlockedID}
+	if _, ok := s.blocks[k]; ok {
+		return nil, biz.ErrAlreadyBlocked
+	}
+	nb := &biz.Block{BlockerID: b.BlockerID, BlockedID: b.BlockedID, CreatedAt: now()}
+	s.blocks[k] = nb
+	c := *nb
+	return &c, nil
+}
+
+func (r *memoryBlockRepo) Delete(ctx context.Context, blockerID, blockedID int64) error {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	k := edgeKey{blockerID, blockedID}
+	if _, ok := s.blocks[k]; !ok {
+		return biz.ErrNotBlocked
+	}
+	delete(s.blocks, k)
+	return nil
+}
+
+func (r *memoryBlockRepo) Exists(ctx context.Context, blockerID, blockedID int64) (bool, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	_, ok := s.blocks[edgeKey{blockerID, blockedID}]
+	return ok, nil
+}
+
+func (r *memoryBlockRepo) Blockers(ctx context.Context, blockedID int64, blockerIDs []int64) ([]int64, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	var ids []int64
+	for _, id := range blockerIDs {
+		if _, ok := s.blocks[edgeKey{id, blockedID}]; ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
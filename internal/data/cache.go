@@ -0,0 +1,140 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// cache is a JSON value cache in front of the database. It only relies on
+// GET, MGET, SET with expiry and DEL, so tests run it against miniredis.
+// A cache without a client is disabled: every lookup misses and writes are
+// dropped.
+type cache struct {
+	rdb redis.Cmdable
+	log *log.Helper
+}
+
+// newRedisClient builds a client from c, or returns nil when redis is not
+// configured.
+func newRedisClient(c *conf.Data_Redis) *redis.Client {
+	if c == nil || c.Addr == "" {
+		return nil
+	}
+	opts := &redis.Options{
+		Network: c.Network,
+		Addr:    c.Addr,
+	}
+	if c.ReadTimeout != nil {
+		opts.ReadTimeout = c.ReadTimeout.AsDuration()
+	}
+	if c.WriteTimeout != nil {
+		opts.WriteTimeout = c.WriteTimeout.AsDuration()
+	}
+	return redis.NewClient(opts)
+}
+
+// get decodes the value stored under key into v and reports whether it was
+// found. Redis failures are logged and reported as a miss so that reads
+// fall through to the database.
+func (c *cache) get(ctx context.Context, key string, v interface{}) bool {
+	if c.rdb == nil {
+		return false
+	}
+	b, err := c.rdb.Get(ctx, key).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			c.log.WithContext(ctx).Warnf("cache get %s: %v", key, err)
+		}
+		return false
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		c.log.WithContext(ctx).Warnf("cache decode %s: %v", key, err)
+		return false
+	}
+	return true
+}
+
+// mget decodes the values stored under keys into the values v returns for
+// their index, and reports which were found. It fails like get, so a Redis
+// failure misses every key.
+func (c *cache) mget(ctx context.Context, keys []string, v func(i int) interface{}) []bool {
+	found := make([]bool, len(keys))
+	if c.rdb == nil || len(keys) == 0 {
+		return found
+	}
+	vals, err := c.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		c.log.WithContext(ctx).Warnf("cache mget %d keys: %v", len(keys), err)
+		return found
+	}
+	for i, val := range vals {
+		s, ok := val.(string)
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal([]byte(s), v(i)); err != nil {
+			c.log.WithContext(ctx).Warnf("cache decode %s: %v", keys[i], err)
+			continue
+		}
+		found[i] = true
+	}
+	return found
+}
+
+// mset stores vs under keys for ttl in a single round trip.
+func (c *cache) mset(ctx context.Context, keys []string, vs []interface{}, ttl time.Duration) {
+	if c.rdb == nil || len(keys) == 0 {
+		return
+	}
+	_, err := c.rdb.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for i, key := range keys {
+			b, err := json.Marshal(vs[i])
+			if err != nil {
+				c.log.WithContext(ctx).Warnf("cache encode %s: %v", key, err)
+				continue
+			}
+			p.Set(ctx, key, b, ttl)
+		}
+		return nil
+	})
+	if err != nil {
+		c.log.WithContext(ctx).Warnf("cache mset %d keys: %v", len(keys), err)
+	}
+}
+
+// set stores v under key for ttl.
+func (c *cache) set(ctx context.Context, key string, v interface{}, ttl time.Duration) {
+	if c.rdb == nil {
+		return
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		c.log.WithContext(ctx).Warnf("cache encode %s: %v", key, err)
+		return
+	}
+	if err := c.rdb.Set(ctx, key, b, ttl).Err(); err != nil {
+		c.log.WithContext(ctx).Warnf("cache set %s: %v", key, err)
+	}
+}
+
+// del removes keys from the cache. In a transaction they are removed once
+// it commits: removing them earlier would let concurrent reads cache the
+// rows it is replacing until they expire.
+func (c *cache) del(ctx context.Context, keys ...string) {
+	if c.rdb == nil || len(keys) == 0 {
+		return
+	}
+	if stale, ok := ctx.Value(contextStaleKey{}).(*staleKeys); ok {
+		stale.keys = append(stale.keys, keys...)
+		return
+	}
+	if err := c.rdb.Del(ctx, keys...).Err(); err != nil {
+		c.log.WithContext(ctx).Warnf("cache del %v: %v", keys, err)
+	}
+}
@@ -0,0 +1,140 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+type cachedValue struct {
+	Name string
+}
+
+// newTestCache returns a cache backed by a new miniredis server.
+func newTestCache(t *testing.T) (*cache, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	return &cache{rdb: rdb, log: log.NewHelper(log.DefaultLogger)}, mr
+}
+
+func TestCacheGetSet(t *testing.T) {
+	c, mr := newTestCache(t)
+	ctx := context.Background()
+	var v cachedValue
+	if c.get(ctx, "k", &v) {
+		t.Fatal("get() hit an empty cache")
+	}
+	c.set(ctx, "k", cachedValue{Name: "jane"}, time.Minute)
+	if !c.get(ctx, "k", &v) || v.Name != "jane" {
+		t.Fatalf("get() = %+v, want jane", v)
+	}
+	mr.FastForward(time.Minute)
+	if c.get(ctx, "k", &v) {
+		t.Error("get() hit an expired key")
+	}
+	mr.Set("bad", "{")
+	if c.get(ctx, "bad", &v) {
+		t.Error("get() hit an undecodable value")
+	}
+}
+
+func TestCacheMGetMSet(t *testing.T) {
+	c, _ := newTestCache(t)
+	ctx := context.Background()
+	c.mset(ctx, []string{"a", "c"}, []interface{}{cachedValue{Name: "a"}, cachedValue{Name: "c"}}, time.Minute)
+	vs := make([]cachedValue, 3)
+	found := c.mget(ctx, []string{"a", "b", "c"}, func(i int) interface{} { return &vs[i] })
+	if !found[0] || found[1] || !found[2] {
+		t.Fatalf("mget() found = %v, want [true false true]", found)
+	}
+	if vs[0].Name != "a" || vs[2].Name != "c" {
+		t.Errorf("mget() = %+v", vs)
+	}
+}
+
+func TestCacheDel(t *testing.T) {
+	c, mr := newTestCache(t)
+	ctx := context.Background()
+	c.set(ctx, "a", cachedValue{}, time.Minute)
+	c.set(ctx, "b", cachedValue{}, time.Minute)
+	c.del(ctx, "a", "b", "missing")
+	if mr.Exists("a") || mr.Exists("b") {
+		t.Error("del() left keys")
+	}
+}
+
+func TestCacheRedisDown(t *testing.T) {
+	c, mr := newTestCache(t)
+	ctx := context.Background()
+	c.set(ctx, "k", cachedValue{Name: "jane"}, time.Minute)
+	mr.Close()
+	var v cachedValue
+	if c.get(ctx, "k", &v) {
+		t.Error("get() hit with redis down")
+	}
+	if found := c.mget(ctx, []string{"k"}, func(int) interface{} { return &v }); found[0] {
+		t.Error("mget() hit with redis down")
+	}
+	// Writes are dropped without failing the caller.
+	c.set(ctx, "k", v, time.Minute)
+	c.mset(ctx, []string{"k"}, []interface{}{v}, time.Minute)
+	c.del(ctx, "k")
+}
+
+func TestCacheDisabled(t *testing.T) {
+	c := &cache{log: log.NewHelper(log.DefaultLogger)}
+	ctx := context.Background()
+	c.set(ctx, "k", cachedValue{Name: "jane"}, time.Minute)
+	var v cachedValue
+	if c.get(ctx, "k", &v) {
+		t.Error("get() hit a disabled cache")
+	}
+	c.del(ctx, "k")
+}
+
+func TestCacheDelInTransaction(t *testing.T) {
+	c, mr := newTestCache(t)
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	d := &Data{db: db, dialect: "sqlite", cache: c}
+	ctx := context.Background()
+
+	c.set(ctx, "k", cachedValue{}, time.Minute)
+	err = d.ExecTx(ctx, func(ctx context.Context) error {
+		c.del(ctx, "k")
+		if !mr.Exists("k") {
+			t.Error("del() removed the key before the commit")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists("k") {
+		t.Error("del() kept the key after the commit")
+	}
+
+	c.set(ctx, "k", cachedValue{}, time.Minute)
+	errRollback := errors.New("rollback")
+	err = d.ExecTx(ctx, func(ctx context.Context) error {
+		c.del(ctx, "k")
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("ExecTx() = %v, want %v", err, errRollback)
+	}
+	if !mr.Exists("k") {
+		t.Error("del() removed the key of a rolled back transaction")
+	}
+}
@@ -0,0 +1,62 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type credentialRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCredentialRepo .
+func NewCredentialRepo(data *Data, logger log.Logger) biz.CredentialRepo {
+	if data.mem != nil {
+		return &memoryCredentialRepo{data: data}
+	}
+	return &credentialRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *credentialRepo) Save(ctx context.Context, c *biz.Credential) error {
+	t := now()
+	_, err := r.data.conn(ctx).ExecContext(ctx,
+		"INSERT INTO credentials (user_id, password_hash, created_at, updated_at) VALUES (?, ?, ?, ?)",
+		c.UserID, c.PasswordHash, t, t)
+	return err
+}
+
+func (r *credentialRepo) Update(ctx context.Context, c *biz.Credential) error {
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"UPDATE credentials SET password_hash = ?, updated_at = ? WHERE user_id = ?",
+		c.PasswordHash, now(), c.UserID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return biz.ErrCredentialNotFound
+	}
+	return nil
+}
+
+func (r *credentialRepo) FindByUserID(ctx context.Context, userID int64) (*biz.Credential, error) {
+	c := &biz.Credential{UserID: userID}
+	err := r.data.conn(ctx).QueryRowContext(ctx,
+		"SELECT password_hash FROM credentials WHERE user_id = ?", userID).Scan(&c.PasswordHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrCredentialNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,48 @@
+package data
+
+import (
+	"context"
+
+	"user/internal/biz"
+)
+
+// memoryCredentialRepo is the biz.CredentialRepo of the memory driver.
+type memoryCredentialRepo struct {
+	data *Data
+}
+
+func (r *memoryCredentialRepo) Save(ctx context.Context, c *biz.Credential) error {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	if _, ok := s.users[c.UserID]; !ok {
+		return biz.ErrUserNotFound
+	}
+	if _, ok := s.credentials[c.UserID]; ok {
+		return biz.ErrUserAlreadyExists
+	}
+	nc := *c
+	s.credentials[c.UserID] = &nc
+	return nil
+}
+
+func (r *memoryCredentialRepo) Update(ctx context.Context, c *biz.Credential) error {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	if _, ok := s.credentials[c.UserID]; !ok {
+		return biz.ErrCredentialNotFound
+	}
+	nc := *c
+	s.credentials[c.UserID] = &nc
+	return nil
+}
+
+func (r *memoryCredentialRepo) FindByUserID(ctx context.Context, userID int64) (*biz.Credential, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	c, ok := s.credentials[userID]
+	if !ok {
+		return nil, biz.ErrCredentialNotFound
+	}
+	nc := *c
+	return &nc, nil
+}
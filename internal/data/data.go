@@ -1,23 +1,260 @@
 package data
 
 import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"user/internal/biz"
 	"user/internal/conf"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-sql-driver/mysql"
 	"github.com/google/wire"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewGreeterRepo)
+var ProviderSet = wire.NewSet(NewData, NewTransaction, NewUserRepo, NewCredentialRepo, NewRefreshTokenRepo, NewGraphRepo, NewFollowRequestRepo, NewBlockRepo, NewMuteRepo, NewHandleChangeRepo, NewEmailVerificationRepo, NewPasswordResetRepo, NewTOTPRepo, NewLoginChallengeRepo, NewSessionRepo, NewSessionCache, NewOAuthClientRepo, NewAuthorizationCodeRepo, NewLinkedIdentityRepo, NewFederatedLoginRepo, NewIdentityProviders, NewAPIKeyRepo, NewMailer)
 
 // Data .
 type Data struct {
-	// TODO wrapped database client
+	// db is nil when the memory driver is configured, mem is nil otherwise.
+	db      *sql.DB
+	mem     *memoryStore
+	dialect string
+	cache   *cache
+	// secrets is nil without an encryption key.
+	secrets *secretBox
 }
 
 // NewData .
 func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
+	helper := log.NewHelper(logger)
+	d := &Data{cache: &cache{log: helper}}
+	if c.Database != nil && c.Database.Driver == memoryDriver {
+		// The memory driver runs without any external service, so redis
+		// is not used either.
+		helper.Warn("using the in-memory database, all data is lost on exit")
+		d.mem, d.dialect = newMemoryStore(), memoryDriver
+		return d, func() {}, nil
+	}
+	secrets, err := newSecretBox(c.GetEncryption().GetKey())
+	if err != nil {
+		return nil, nil, err
+	}
+	if secrets == nil {
+		helper.Warn("data.encryption.key is not configured, TOTP cannot be enrolled")
+	}
+	d.secrets = secrets
+	db, dialect, err := openDB(c.Database)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkMigrations(db, dialect, c.Database, logger); err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+	d.db, d.dialect = db, dialect
+	rdb := newRedisClient(c.Redis)
+	if rdb != nil {
+		// Leave the interface nil rather than holding a nil *redis.Client.
+		d.cache.rdb = rdb
+	}
 	cleanup := func() {
-		log.NewHelper(logger).Info("closing the data resources")
+		helper.Info("closing the data resources")
+		if err := db.Close(); err != nil {
+			helper.Error(err)
+		}
+		if rdb != nil {
+			if err := rdb.Close(); err != nil {
+				helper.Error(err)
+			}
+		}
+	}
+	return d, cleanup, nil
+}
+
+// openDB opens a connection pool for the configured driver and source and
+// returns it with the dialect used to pick migrations.
+func openDB(c *conf.Data_Database) (*sql.DB, string, error) {
+	if c == nil {
+		return nil, "", fmt.Errorf("data: database is not configured")
+	}
+	var driver, source string
+	switch c.Driver {
+	case "mysql":
+		cfg, err := mysql.ParseDSN(c.Source)
+		if err != nil {
+			return nil, "", err
+		}
+		// Scan DATETIME columns into time.Time, always in UTC.
+		cfg.ParseTime = true
+		cfg.Loc = time.UTC
+		driver, source = "mysql", cfg.FormatDSN()
+	case "sqlite", "sqlite3":
+		driver, source = "sqlite", c.Source
+		// Deletes cascade through foreign keys, which sqlite only enforces
+		// when asked to on every connection.
+		if !strings.Contains(source, "foreign_keys") {
+			sep := "?"
+			if strings.Contains(source, "?") {
+				sep = "&"
+			}
+			source += sep + "_pragma=foreign_keys(1)"
+		}
+	case memoryDriver:
+		return nil, "", fmt.Errorf("data: the %s driver has no schema to manage", memoryDriver)
+	default:
+		return nil, "", fmt.Errorf("data: unsupported database driver %q", c.Driver)
+	}
+	db, err := sql.Open(driver, source)
+	if err != nil {
+		return nil, "", err
+	}
+	if driver == "sqlite" {
+		// sqlite serializes writers; a single connection avoids SQLITE_BUSY
+		// and keeps in-memory databases alive for the whole process.
+		db.SetMaxOpenConns(1)
+	}
+	return db, driver, nil
+}
+
+// checkMigrations refuses a schema that is behind the embedded migrations
+// unless the configuration explicitly allows it.
+func checkMigrations(db *sql.DB, dialect string, c *conf.Data_Database, logger log.Logger) error {
+	m, err := newMigrator(db, dialect, logger)
+	if err != nil {
+		return err
+	}
+	pending, err := m.Pending(context.Background())
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	if c.AllowPendingMigrations {
+		log.NewHelper(logger).Warnf("starting with %d pending migration(s): %v", len(pending), pending)
+		return nil
+	}
+	return fmt.Errorf("data: %d pending migration(s) %v, run `user migrate up` first", len(pending), pending)
+}
+
+type contextTxKey struct{}
+
+// contextStaleKey holds the *staleKeys of a transaction.
+type contextStaleKey struct{}
+
+// staleKeys are the cache keys a transaction invalidates once it commits.
+type staleKeys struct {
+	keys []string
+}
+
+// querier is what repos need from either *sql.DB or *sql.Tx.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// NewTransaction .
+func NewTransaction(d *Data) biz.Transaction {
+	return d
+}
+
+// ExecTx runs fn in a database transaction, committing it when fn returns
+// nil. Nested calls join the outer transaction. The cache keys fn deletes
+// are only deleted once the transaction commits.
+func (d *Data) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if d.mem != nil {
+		return d.mem.execTx(ctx, fn)
+	}
+	if _, ok := ctx.Value(contextTxKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	stale := &staleKeys{}
+	txCtx := context.WithValue(context.WithValue(ctx, contextTxKey{}, tx), contextStaleKey{}, stale)
+	if err := fn(txCtx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w (rollback: %v)", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	d.cache.del(ctx, stale.keys...)
+	return nil
+}
+
+// conn returns the transaction ctx runs in, or the connection pool.
+func (d *Data) conn(ctx context.Context) querier {
+	if tx, ok := ctx.Value(contextTxKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return d.db
+}
+
+// now returns the current time at the precision stored by the database.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
+
+// placeholders returns the placeholders of an IN list of n values.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// isDuplicate reports whether err is a unique constraint violation.
+func isDuplicate(err error) bool {
+	var me *mysql.MySQLError
+	if errors.As(err, &me) {
+		return me.Number == 1062
+	}
+	var se *sqlite.Error
+	if errors.As(err, &se) {
+		return se.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE || se.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY
+	}
+	return false
+}
+
+// duplicateKey returns what a unique constraint violation names: the index
+// on MySQL, as in "Duplicate entry 'x' for key 'users.uk_users_email'",
+// and the columns on SQLite, as in "UNIQUE constraint failed: users.email".
+// It returns "" for other errors.
+func duplicateKey(err error) string {
+	var me *mysql.MySQLError
+	if errors.As(err, &me) && me.Number == 1062 {
+		const marker = " for key '"
+		i := strings.LastIndex(me.Message, marker)
+		if i < 0 {
+			return ""
+		}
+		key := strings.TrimSuffix(me.Message[i+len(marker):], "'")
+		// MySQL 8 prefixes the index with its table.
+		return key[strings.LastIndexByte(key, '.')+1:]
+	}
+	var se *sqlite.Error
+	if errors.As(err, &se) && isDuplicate(err) {
+		const marker = "UNIQUE constraint failed: "
+		msg := se.Error()
+		i := strings.Index(msg, marker)
+		if i < 0 {
+			return ""
+		}
+		key := msg[i+len(marker):]
+		if j := strings.Index(key, " ("); j >= 0 {
+			key = key[:j]
+		}
+		return key
 	}
-	return &Data{}, cleanup, nil
+	return ""
 }
@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const emailVerificationColumns = "user_id, token_hash, email, created_at, expires_at"
+
+type emailVerificationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewEmailVerificationRepo .
+func NewEmailVerificationRepo(data *Data, logger log.Logger) biz.EmailVerificationRepo {
+	if data.mem != nil {
+		return &memoryEmailVerificationRepo{data: data}
+	}
+	return &emailVerificationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *emailVerificationRepo) Save(ctx context.Context, v *biz.EmailVerification) (*biz.EmailVerification, error) {
+	nv := *v
+	nv.CreatedAt = now()
+	nv.ExpiresAt = v.ExpiresAt.UTC().Truncate(time.Microsecond)
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		if _, err := r.data.conn(ctx).ExecContext(ctx, "DELETE FROM email_verifications WHERE user_id = ?", nv.UserID); err != nil {
+			return err
+		}
+		_, err := r.data.conn(ctx).ExecContext(ctx,
+			"INSERT INTO email_verifications ("+emailVerificationColumns+") VALUES (?, ?, ?, ?, ?)",
+			nv.UserID, nv.TokenHash, nv.Email, nv.CreatedAt, nv.ExpiresAt)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nv, nil
+}
+
+func (r *emailVerificationRepo) Find(ctx context.Context, userID int64) (*biz.EmailVerification, error) {
+	return r.find(ctx, "SELECT "+emailVerificationColumns+" FROM email_verifications WHERE user_id = ?", userID)
+}
+
+func (r *emailVerificationRepo) FindByTokenHash(ctx context.Context, hash string) (*biz.EmailVerification, error) {
+	return r.find(ctx, "SELECT "+emailVerificationColumns+" FROM email_verifications WHERE token_hash = ?", hash)
+}
+
+func (r *emailVerificationRepo) find(ctx context.Context, query string, arg interface{}) (*biz.EmailVerification, error) {
+	var v biz.EmailVerification
+	err := r.data.conn(ctx).QueryRowContext(ctx, query, arg).
+		Scan(&v.UserID, &v.TokenHash, &v.Email, &v.CreatedAt, &v.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrVerificationTokenInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (r *emailVerificationRepo) Delete(ctx context.Context, userID int64) error {
+	_, err := r.data.conn(ctx).ExecContext(ctx, "DELETE FROM email_verifications WHERE user_id = ?", userID)
+	return err
+}
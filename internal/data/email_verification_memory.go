@@ -0,0 +1,58 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"user/internal/biz"
+)
+
+// memoryEmailVerificationRepo is the biz.EmailVerificationRepo of the memory
+// driver.
+type memoryEmailVerificationRepo struct {
+	data *Data
+}
+
+func (r *memoryEmailVerificationRepo) Save(ctx context.Context, v *biz.EmailVerification) (*biz.EmailVerification, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	if _, ok := s.users[v.UserID]; !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	nv := *v
+	nv.CreatedAt = now()
+	nv.ExpiresAt = v.ExpiresAt.UTC().Truncate(time.Microsecond)
+	s.emailVerifications[nv.UserID] = &nv
+	c := nv
+	return &c, nil
+}
+
+func (r *memoryEmailVerificationRepo) Find(ctx context.Context, userID int64) (*biz.EmailVerification, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	v, ok := s.emailVerifications[userID]
+	if !ok {
+		return nil, biz.ErrVerificationTokenInvalid
+	}
+	c := *v
+	return &c, nil
+}
+
+func (r *memoryEmailVerificationRepo) FindByTokenHash(ctx context.Context, hash string) (*biz.EmailVerification, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	for _, v := range s.emailVerifications {
+		if v.TokenHash == hash {
+			c := *v
+			return &c, nil
+		}
+	}
+	return nil, biz.ErrVerificationTokenInvalid
+}
+
+func (r *memoryEmailVerificationRepo) Delete(ctx context.Context, userID int64) error {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	delete(s.emailVerifications, userID)
+	return nil
+}
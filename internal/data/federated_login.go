@@ -0,0 +1,81 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const federatedLoginColumns = "state_hash, provider, nonce, code_verifier, created_at, expires_at"
+
+type federatedLoginRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewFederatedLoginRepo .
+func NewFederatedLoginRepo(data *Data, logger log.Logger) biz.FederatedLoginRepo {
+	if data.mem != nil {
+		return &memoryFederatedLoginRepo{data: data}
+	}
+	return &federatedLoginRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *federatedLoginRepo) Save(ctx context.Context, l *biz.FederatedLogin) error {
+	nl := newFederatedLogin(l)
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		if _, err := r.data.conn(ctx).ExecContext(ctx, "DELETE FROM federated_logins WHERE expires_at <= ?", nl.CreatedAt); err != nil {
+			return err
+		}
+		_, err := r.data.conn(ctx).ExecContext(ctx,
+			"INSERT INTO federated_logins ("+federatedLoginColumns+") VALUES (?, ?, ?, ?, ?, ?)",
+			nl.StateHash, nl.Provider, nl.Nonce, nl.CodeVerifier, nl.CreatedAt, nl.ExpiresAt)
+		return err
+	})
+}
+
+// Take deletes the row it read, and fails if a concurrent Take deleted it
+// first.
+func (r *federatedLoginRepo) Take(ctx context.Context, hash string) (*biz.FederatedLogin, error) {
+	var l biz.FederatedLogin
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		err := r.data.conn(ctx).QueryRowContext(ctx,
+			"SELECT "+federatedLoginColumns+" FROM federated_logins WHERE state_hash = ?", hash).
+			Scan(&l.StateHash, &l.Provider, &l.Nonce, &l.CodeVerifier, &l.CreatedAt, &l.ExpiresAt)
+		if errors.Is(err, sql.ErrNoRows) {
+			return biz.ErrFederatedLoginInvalid
+		}
+		if err != nil {
+			return err
+		}
+		res, err := r.data.conn(ctx).ExecContext(ctx, "DELETE FROM federated_logins WHERE state_hash = ?", hash)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return biz.ErrFederatedLoginInvalid
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// newFederatedLogin returns the row of a new login.
+func newFederatedLogin(l *biz.FederatedLogin) *biz.FederatedLogin {
+	nl := *l
+	nl.CreatedAt = now()
+	nl.ExpiresAt = l.ExpiresAt.UTC().Truncate(time.Microsecond)
+	return &nl
+}
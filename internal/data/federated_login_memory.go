@@ -0,0 +1,38 @@
+package data
+
+import (
+	"context"
+
+	"user/internal/biz"
+)
+
+// memoryFederatedLoginRepo is the biz.FederatedLoginRepo of the memory
+// driver.
+type memoryFederatedLoginRepo struct {
+	data *Data
+}
+
+func (r *memoryFederatedLoginRepo) Save(ctx context.Context, l *biz.FederatedLogin) error {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	nl := newFederatedLogin(l)
+	for k, o := range m.federatedLogins {
+		if !o.ExpiresAt.After(nl.CreatedAt) {
+			delete(m.federatedLogins, k)
+		}
+	}
+	m.federatedLogins[nl.StateHash] = nl
+	return nil
+}
+
+func (r *memoryFederatedLoginRepo) Take(ctx context.Context, hash string) (*biz.FederatedLogin, error) {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	l, ok := m.federatedLogins[hash]
+	if !ok {
+		return nil, biz.ErrFederatedLoginInvalid
+	}
+	delete(m.federatedLogins, hash)
+	c := *l
+	return &c, nil
+}
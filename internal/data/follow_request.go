@@ -0,0 +1,121 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const followRequestColumns = "requester_id, target_id, created_at, expires_at"
+
+type followRequestRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewFollowRequestRepo .
+func NewFollowRequestRepo(data *Data, logger log.Logger) biz.FollowRequestRepo {
+	if data.mem != nil {
+		return &memoryFollowRequestRepo{data: data}
+	}
+	return &followRequestRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *followRequestRepo) Save(ctx context.Context, fr *biz.FollowRequest) (*biz.FollowRequest, error) {
+	nr := *fr
+	nr.CreatedAt = now()
+	nr.ExpiresAt = fr.ExpiresAt.UTC().Truncate(time.Microsecond)
+	_, err := r.data.conn(ctx).ExecContext(ctx,
+		"INSERT INTO follow_requests ("+followRequestColumns+") VALUES (?, ?, ?, ?)",
+		nr.RequesterID, nr.TargetID, nr.CreatedAt, nr.ExpiresAt)
+	if isDuplicate(err) {
+		return nil, biz.ErrAlreadyRequested
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &nr, nil
+}
+
+func (r *followRequestRepo) Find(ctx context.Context, requesterID, targetID int64) (*biz.FollowRequest, error) {
+	var fr biz.FollowRequest
+	err := r.data.conn(ctx).QueryRowContext(ctx,
+		"SELECT "+followRequestColumns+" FROM follow_requests WHERE requester_id = ? AND target_id = ?", requesterID, targetID).
+		Scan(&fr.RequesterID, &fr.TargetID, &fr.CreatedAt, &fr.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrFollowRequestNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &fr, nil
+}
+
+func (r *followRequestRepo) Delete(ctx context.Context, requesterID, targetID int64) error {
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"DELETE FROM follow_requests WHERE requester_id = ? AND target_id = ?", requesterID, targetID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return biz.ErrFollowRequestNotFound
+	}
+	return nil
+}
+
+func (r *followRequestRepo) List(ctx context.Context, targetID int64, at time.Time, page *biz.Page) ([]*biz.FollowRequest, error) {
+	query := "SELECT " + followRequestColumns + " FROM follow_requests WHERE target_id = ? AND expires_at > ?"
+	args := []interface{}{targetID, at.UTC()}
+	if a := page.After; a != nil {
+		query += " AND (created_at < ? OR (created_at = ? AND requester_id < ?))"
+		args = append(args, a.Time, a.Time, a.ID)
+	}
+	query += " ORDER BY created_at DESC, requester_id DESC LIMIT ?"
+	args = append(args, page.Size+1)
+	return r.list(ctx, query, args...)
+}
+
+func (r *followRequestRepo) ListAll(ctx context.Context, targetID int64, at time.Time) ([]*biz.FollowRequest, error) {
+	return r.list(ctx,
+		"SELECT "+followRequestColumns+" FROM follow_requests WHERE target_id = ? AND expires_at > ? ORDER BY created_at, requester_id",
+		targetID, at.UTC())
+}
+
+func (r *followRequestRepo) list(ctx context.Context, query string, args ...interface{}) ([]*biz.FollowRequest, error) {
+	rows, err := r.data.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var rs []*biz.FollowRequest
+	for rows.Next() {
+		var fr biz.FollowRequest
+		if err := rows.Scan(&fr.RequesterID, &fr.TargetID, &fr.CreatedAt, &fr.ExpiresAt); err != nil {
+			return nil, err
+		}
+		rs = append(rs, &fr)
+	}
+	return rs, rows.Err()
+}
+
+func (r *followRequestRepo) DeleteAll(ctx context.Context, targetID int64) error {
+	_, err := r.data.conn(ctx).ExecContext(ctx, "DELETE FROM follow_requests WHERE target_id = ?", targetID)
+	return err
+}
+
+func (r *followRequestRepo) DeleteExpired(ctx context.Context, at time.Time) (int64, error) {
+	res, err := r.data.conn(ctx).ExecContext(ctx, "DELETE FROM follow_requests WHERE expires_at <= ?", at.UTC())
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
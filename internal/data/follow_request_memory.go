@@ -0,0 +1,126 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"user/internal/biz"
+)
+
+// memoryFollowRequestRepo is the biz.FollowRequestRepo of the memory driver.
+type memoryFollowRequestRepo struct {
+	data *Data
+}
+
+func (r *memoryFollowRequestRepo) Save(ctx context.Context, fr *biz.FollowRequest) (*biz.FollowRequest, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	if _, ok := s.users[fr.RequesterID]; !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	if _, ok := s.users[fr.TargetID]; !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	k := edgeKey{fr.RequesterID, fr.TargetID}
+	if _, ok := s.followRequests[k]; ok {
+		return nil, biz.ErrAlreadyRequested
+	}
+	nr := *fr
+	nr.CreatedAt = now()
+	nr.ExpiresAt = fr.ExpiresAt.UTC().Truncate(time.Microsecond)
+	s.followRequests[k] = &nr
+	c := nr
+	return &c, nil
+}
+
+func (r *memoryFollowRequestRepo) Find(ctx context.Context, requesterID, targetID int64) (*biz.FollowRequest, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	fr, ok := s.followRequests[edgeKey{requesterID, targetID}]
+	if !ok {
+		return nil, biz.ErrFollowRequestNotFound
+	}
+	c := *fr
+	return &c, nil
+}
+
+func (r *memoryFollowRequestRepo) Delete(ctx context.Context, requesterID, targetID int64) error {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	k := edgeKey{requesterID, targetID}
+	if _, ok := s.followRequests[k]; !ok {
+		return biz.ErrFollowRequestNotFound
+	}
+	delete(s.followRequests, k)
+	return nil
+}
+
+func (r *memoryFollowRequestRepo) List(ctx context.Context, targetID int64, at time.Time, page *biz.Page) ([]*biz.FollowRequest, error) {
+	rs := r.pending(ctx, targetID, at)
+	sort.Slice(rs, func(i, j int) bool {
+		if !rs[i].CreatedAt.Equal(rs[j].CreatedAt) {
+			return rs[i].CreatedAt.After(rs[j].CreatedAt)
+		}
+		return rs[i].RequesterID > rs[j].RequesterID
+	})
+	if a := page.After; a != nil {
+		i := sort.Search(len(rs), func(i int) bool {
+			return rs[i].CreatedAt.Before(a.Time) || rs[i].CreatedAt.Equal(a.Time) && rs[i].RequesterID < a.ID
+		})
+		rs = rs[i:]
+	}
+	if len(rs) > page.Size+1 {
+		rs = rs[:page.Size+1]
+	}
+	return rs, nil
+}
+
+func (r *memoryFollowRequestRepo) ListAll(ctx context.Context, targetID int64, at time.Time) ([]*biz.FollowRequest, error) {
+	rs := r.pending(ctx, targetID, at)
+	sort.Slice(rs, func(i, j int) bool {
+		if !rs[i].CreatedAt.Equal(rs[j].CreatedAt) {
+			return rs[i].CreatedAt.Before(rs[j].CreatedAt)
+		}
+		return rs[i].RequesterID < rs[j].RequesterID
+	})
+	return rs, nil
+}
+
+// pending returns copies of the requests to targetID unexpired at at.
+func (r *memoryFollowRequestRepo) pending(ctx context.Context, targetID int64, at time.Time) []*biz.FollowRequest {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	var rs []*biz.FollowRequest
+	for k, fr := range s.followRequests {
+		if k.to == targetID && fr.ExpiresAt.After(at) {
+			c := *fr
+			rs = append(rs, &c)
+		}
+	}
+	return rs
+}
+
+func (r *memoryFollowRequestRepo) DeleteAll(ctx context.Context, targetID int64) error {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	for k := range s.followRequests {
+		if k.to == targetID {
+			delete(s.followRequests, k)
+		}
+	}
+	return nil
+}
+
+func (r *memoryFollowRequestRepo) DeleteExpired(ctx context.Context, at time.Time) (int64, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	var n int64
+	for k, fr := range s.followRequests {
+		if !fr.ExpiresAt.After(at) {
+			delete(s.followRequests, k)
+			n++
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,167 @@
+package data
+
+import (
+	"context"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const followColumns = "follower_id, followee_id, created_at"
+
+type graphRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewGraphRepo .
+func NewGraphRepo(data *Data, logger log.Logger) biz.GraphRepo {
+	if data.mem != nil {
+		return &memoryGraphRepo{data: data}
+	}
+	return &graphRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *graphRepo) Save(ctx context.Context, f *biz.Follow) (*biz.Follow, error) {
+	t := now()
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		_, err := r.data.conn(ctx).ExecContext(ctx,
+			"INSERT INTO follows (follower_id, followee_id, created_at) VALUES (?, ?, ?)",
+			f.FollowerID, f.FolloweeID, t)
+		if isDuplicate(err) {
+			return biz.ErrAlreadyFollowing
+		}
+		if err != nil {
+			return err
+		}
+		return r.addCounts(ctx, f.FollowerID, f.FolloweeID, 1)
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.invalidate(ctx, f.FollowerID, f.FolloweeID)
+	return &biz.Follow{FollowerID: f.FollowerID, FolloweeID: f.FolloweeID, CreatedAt: t}, nil
+}
+
+func (r *graphRepo) Delete(ctx context.Context, followerID, followeeID int64) error {
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		res, err := r.data.conn(ctx).ExecContext(ctx,
+			"DELETE FROM follows WHERE follower_id = ? AND followee_id = ?", followerID, followeeID)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return biz.ErrNotFollowing
+		}
+		return r.addCounts(ctx, followerID, followeeID, -1)
+	})
+	if err != nil {
+		return err
+	}
+	r.invalidate(ctx, followerID, followeeID)
+	return nil
+}
+
+// addCounts adds delta to the following count of followerID and the
+// follower count of followeeID.
+func (r *graphRepo) addCounts(ctx context.Context, followerID, followeeID int64, delta int) error {
+	if _, err := r.data.conn(ctx).ExecContext(ctx,
+		"UPDATE users SET following_count = following_count + ? WHERE id = ?", delta, followerID); err != nil {
+		return err
+	}
+	_, err := r.data.conn(ctx).ExecContext(ctx,
+		"UPDATE users SET follower_count = follower_count + ? WHERE id = ?", delta, followeeID)
+	return err
+}
+
+// invalidate drops the cached users whose counts changed.
+func (r *graphRepo) invalidate(ctx context.Context, ids ...int64) {
+	for _, id := range ids {
+		r.data.cache.del(ctx, userCacheKey(id))
+	}
+}
+
+// ReconcileCounts finds the users whose counts differ from the follows,
+// then recounts each of them in a single statement, so that follows made
+// in between are not lost.
+func (r *graphRepo) ReconcileCounts(ctx context.Context) (int64, error) {
+	rows, err := r.data.conn(ctx).QueryContext(ctx, `SELECT id FROM (
+  SELECT u.id, u.follower_count, u.following_count,
+    (SELECT COUNT(*) FROM follows f WHERE f.followee_id = u.id) AS followers,
+    (SELECT COUNT(*) FROM follows f WHERE f.follower_id = u.id) AS following
+  FROM users u
+) c WHERE c.follower_count <> c.followers OR c.following_count <> c.following`)
+	if err != nil {
+		return 0, err
+	}
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	for _, id := range ids {
+		if _, err := r.data.conn(ctx).ExecContext(ctx, `UPDATE users SET
+  follower_count = (SELECT COUNT(*) FROM follows WHERE followee_id = ?),
+  following_count = (SELECT COUNT(*) FROM follows WHERE follower_id = ?)
+WHERE id = ?`, id, id, id); err != nil {
+			return 0, err
+		}
+		r.invalidate(ctx, id)
+	}
+	return int64(len(ids)), nil
+}
+
+func (r *graphRepo) Exists(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	var n int
+	err := r.data.conn(ctx).QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM follows WHERE follower_id = ? AND followee_id = ?", followerID, followeeID).Scan(&n)
+	return n > 0, err
+}
+
+func (r *graphRepo) ListFollowers(ctx context.Context, userID int64, page *biz.Page) ([]*biz.Follow, error) {
+	return r.list(ctx, "followee_id", "follower_id", userID, page)
+}
+
+func (r *graphRepo) ListFollowing(ctx context.Context, userID int64, page *biz.Page) ([]*biz.Follow, error) {
+	return r.list(ctx, "follower_id", "followee_id", userID, page)
+}
+
+// list returns a page of the follows whose column is userID, newest first
+// and then by the descending id of the other side.
+func (r *graphRepo) list(ctx context.Context, column, other string, userID int64, page *biz.Page) ([]*biz.Follow, error) {
+	query := "SELECT " + followColumns + " FROM follows WHERE " + column + " = ?"
+	args := []interface{}{userID}
+	if a := page.After; a != nil {
+		query += " AND (created_at < ? OR (created_at = ? AND " + other + " < ?))"
+		args = append(args, a.Time, a.Time, a.ID)
+	}
+	query += " ORDER BY created_at DESC, " + other + " DESC LIMIT ?"
+	args = append(args, page.Size+1)
+	rows, err := r.data.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var fs []*biz.Follow
+	for rows.Next() {
+		var f biz.Follow
+		if err := rows.Scan(&f.FollowerID, &f.FolloweeID, &f.CreatedAt); err != nil {
+			return nil, err
+		}
+		fs = append(fs, &f)
+	}
+	return fs, rows.Err()
+}
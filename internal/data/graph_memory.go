@@ -0,0 +1,135 @@
+package data
+
+import (
+	"context"
+	"sort"
+
+	"user/internal/biz"
+)
+
+// edgeKey is the primary key of a relationship of user from to user to,
+// such as a follow, in the memory driver.
+type edgeKey struct {
+	from, to int64
+}
+
+// memoryGraphRepo is the biz.GraphRepo of the memory driver.
+type memoryGraphRepo struct {
+	data *Data
+}
+
+func (r *memoryGraphRepo) Save(ctx context.Context, f *biz.Follow) (*biz.Follow, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	if _, ok := s.users[f.FollowerID]; !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	if _, ok := s.users[f.FolloweeID]; !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	k := edgeKey{f.FollowerID, f.FolloweeID}
+	if _, ok := s.follows[k]; ok {
+		return nil, biz.ErrAlreadyFollowing
+	}
+	nf := &biz.Follow{FollowerID: f.FollowerID, FolloweeID: f.FolloweeID, CreatedAt: now()}
+	s.follows[k] = nf
+	s.addFollowCounts(f.FolloweeID, 1, 0)
+	s.addFollowCounts(f.FollowerID, 0, 1)
+	c := *nf
+	return &c, nil
+}
+
+func (r *memoryGraphRepo) Delete(ctx context.Context, followerID, followeeID int64) error {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	k := edgeKey{followerID, followeeID}
+	if _, ok := s.follows[k]; !ok {
+		return biz.ErrNotFollowing
+	}
+	delete(s.follows, k)
+	s.addFollowCounts(followeeID, -1, 0)
+	s.addFollowCounts(followerID, 0, -1)
+	return nil
+}
+
+func (r *memoryGraphRepo) ReconcileCounts(ctx context.Context) (int64, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	followers := make(map[int64]int64)
+	following := make(map[int64]int64)
+	for k := range s.follows {
+		followers[k.to]++
+		following[k.from]++
+	}
+	var n int64
+	for id, u := range s.users {
+		if u.FollowerCount == followers[id] && u.FollowingCount == following[id] {
+			continue
+		}
+		nu := copyUser(u)
+		nu.FollowerCount, nu.FollowingCount = followers[id], following[id]
+		s.users[id] = nu
+		n++
+	}
+	return n, nil
+}
+
+// addFollowCounts adds to the follower and following counts of a user.
+// Callers must hold the write lock.
+func (s *memoryStore) addFollowCounts(id int64, followers, following int64) {
+	u, ok := s.users[id]
+	if !ok {
+		return
+	}
+	nu := copyUser(u)
+	nu.FollowerCount += followers
+	nu.FollowingCount += following
+	s.users[id] = nu
+}
+
+func (r *memoryGraphRepo) Exists(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	_, ok := s.follows[edgeKey{followerID, followeeID}]
+	return ok, nil
+}
+
+func (r *memoryGraphRepo) ListFollowers(ctx context.Context, userID int64, page *biz.Page) ([]*biz.Follow, error) {
+	return r.list(ctx, page,
+		func(f *biz.Follow) bool { return f.FolloweeID == userID },
+		func(f *biz.Follow) int64 { return f.FollowerID })
+}
+
+func (r *memoryGraphRepo) ListFollowing(ctx context.Context, userID int64, page *biz.Page) ([]*biz.Follow, error) {
+	return r.list(ctx, page,
+		func(f *biz.Follow) bool { return f.FollowerID == userID },
+		func(f *biz.Follow) int64 { return f.FolloweeID })
+}
+
+// list returns a page of the follows matching match, newest first and then
+// by the descending id of the other side, as the SQL repo orders them.
+func (r *memoryGraphRepo) list(ctx context.Context, page *biz.Page, match func(*biz.Follow) bool, other func(*biz.Follow) int64) ([]*biz.Follow, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	var fs []*biz.Follow
+	for _, f := range s.follows {
+		if !match(f) {
+			continue
+		}
+		if a := page.After; a != nil && !(f.CreatedAt.Before(a.Time) || f.CreatedAt.Equal(a.Time) && other(f) < a.ID) {
+			continue
+		}
+		c := *f
+		fs = append(fs, &c)
+	}
+	sort.Slice(fs, func(i, j int) bool {
+		if !fs[i].CreatedAt.Equal(fs[j].CreatedAt) {
+			return fs[i].CreatedAt.After(fs[j].CreatedAt)
+		}
+		return other(fs[i]) > other(fs[j])
+	})
+	if len(fs) > page.Size+1 {
+		fs = fs[:page.Size+1]
+	}
+	return fs, nil
+}
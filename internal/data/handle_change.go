@@ -0,0 +1,104 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const handleChangeColumns = "user_id, handle, created_at, expires_at"
+
+type handleChangeRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewHandleChangeRepo .
+func NewHandleChangeRepo(data *Data, logger log.Logger) biz.HandleChangeRepo {
+	if data.mem != nil {
+		return &memoryHandleChangeRepo{data: data}
+	}
+	return &handleChangeRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *handleChangeRepo) Save(ctx context.Context, c *biz.HandleChange) (*biz.HandleChange, error) {
+	nc := *c
+	nc.CreatedAt = now()
+	nc.ExpiresAt = c.ExpiresAt.UTC().Truncate(time.Microsecond)
+	_, err := r.data.conn(ctx).ExecContext(ctx,
+		"INSERT INTO handle_changes (user_id, handle, handle_key, created_at, expires_at) VALUES (?, ?, ?, ?, ?)",
+		nc.UserID, nc.Handle, biz.HandleKey(nc.Handle), nc.CreatedAt, nc.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &nc, nil
+}
+
+func (r *handleChangeRepo) Find(ctx context.Context, key string, at time.Time) (*biz.HandleChange, error) {
+	return r.find(ctx,
+		"SELECT "+handleChangeColumns+" FROM handle_changes WHERE handle_key = ? AND expires_at > ? ORDER BY id DESC LIMIT 1",
+		key, at.UTC())
+}
+
+func (r *handleChangeRepo) FindAll(ctx context.Context, keys []string, at time.Time) ([]*biz.HandleChange, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	args := make([]interface{}, 0, len(keys)+1)
+	for _, k := range keys {
+		args = append(args, k)
+	}
+	args = append(args, at.UTC())
+	rows, err := r.data.conn(ctx).QueryContext(ctx,
+		"SELECT handle_key, "+handleChangeColumns+" FROM handle_changes WHERE handle_key IN ("+placeholders(len(keys))+") AND expires_at > ? ORDER BY id",
+		args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	latest := make(map[string]*biz.HandleChange, len(keys))
+	for rows.Next() {
+		var (
+			key string
+			c   biz.HandleChange
+		)
+		if err := rows.Scan(&key, &c.UserID, &c.Handle, &c.CreatedAt, &c.ExpiresAt); err != nil {
+			return nil, err
+		}
+		latest[key] = &c
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	cs := make([]*biz.HandleChange, 0, len(latest))
+	for _, c := range latest {
+		cs = append(cs, c)
+	}
+	return cs, nil
+}
+
+func (r *handleChangeRepo) Last(ctx context.Context, userID int64) (*biz.HandleChange, error) {
+	return r.find(ctx,
+		"SELECT "+handleChangeColumns+" FROM handle_changes WHERE user_id = ? ORDER BY id DESC LIMIT 1",
+		userID)
+}
+
+func (r *handleChangeRepo) find(ctx context.Context, query string, args ...interface{}) (*biz.HandleChange, error) {
+	var c biz.HandleChange
+	err := r.data.conn(ctx).QueryRowContext(ctx, query, args...).
+		Scan(&c.UserID, &c.Handle, &c.CreatedAt, &c.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrHandleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
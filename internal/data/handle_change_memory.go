@@ -0,0 +1,73 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"user/internal/biz"
+)
+
+// memoryHandleChangeRepo is the biz.HandleChangeRepo of the memory driver.
+type memoryHandleChangeRepo struct {
+	data *Data
+}
+
+func (r *memoryHandleChangeRepo) Save(ctx context.Context, c *biz.HandleChange) (*biz.HandleChange, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	if _, ok := s.users[c.UserID]; !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	nc := *c
+	nc.CreatedAt = now()
+	nc.ExpiresAt = c.ExpiresAt.UTC().Truncate(time.Microsecond)
+	s.handleChanges[s.nextID("handle_changes")] = &nc
+	cc := nc
+	return &cc, nil
+}
+
+func (r *memoryHandleChangeRepo) Find(ctx context.Context, key string, at time.Time) (*biz.HandleChange, error) {
+	return r.last(ctx, func(c *biz.HandleChange) bool {
+		return biz.HandleKey(c.Handle) == key && c.ExpiresAt.After(at)
+	})
+}
+
+func (r *memoryHandleChangeRepo) FindAll(ctx context.Context, keys []string, at time.Time) ([]*biz.HandleChange, error) {
+	var cs []*biz.HandleChange
+	for _, k := range keys {
+		c, err := r.Find(ctx, k, at)
+		if errors.Is(err, biz.ErrHandleNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		cs = append(cs, c)
+	}
+	return cs, nil
+}
+
+func (r *memoryHandleChangeRepo) Last(ctx context.Context, userID int64) (*biz.HandleChange, error) {
+	return r.last(ctx, func(c *biz.HandleChange) bool { return c.UserID == userID })
+}
+
+// last returns the latest change matching fn.
+func (r *memoryHandleChangeRepo) last(ctx context.Context, fn func(*biz.HandleChange) bool) (*biz.HandleChange, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	var (
+		id int64
+		c  *biz.HandleChange
+	)
+	for k, v := range s.handleChanges {
+		if k > id && fn(v) {
+			id, c = k, v
+		}
+	}
+	if c == nil {
+		return nil, biz.ErrHandleNotFound
+	}
+	cc := *c
+	return &cc, nil
+}
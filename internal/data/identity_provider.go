@@ -0,0 +1,406 @@
+package data
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"user/internal/biz"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	identityProviderTimeout = 10 * time.Second
+	// identityProviderMaxBody bounds the responses read from providers.
+	identityProviderMaxBody = 1 << 20
+	// jwksRefreshInterval is how often at most the keys of a provider are
+	// fetched again for an ID token signed by an unknown key.
+	jwksRefreshInterval = time.Minute
+)
+
+var defaultIdentityProviderScopes = []string{"openid", "email", "profile"}
+
+// NewIdentityProviders returns the providers of the federation config. They
+// fetch the discovery document of their issuer at the first login, so that
+// the server starts when a provider is down.
+func NewIdentityProviders(c *conf.Federation, logger log.Logger) (biz.IdentityProviders, error) {
+	ps := make(biz.IdentityProviders, len(c.GetProviders()))
+	client := &http.Client{Timeout: identityProviderTimeout}
+	for _, p := range c.GetProviders() {
+		switch {
+		case p.Name == "":
+			return nil, fmt.Errorf("federation: a provider has no name")
+		case p.Issuer == "" || p.ClientId == "" || p.RedirectUrl == "":
+			return nil, fmt.Errorf("federation: provider %q requires issuer, client_id and redirect_url", p.Name)
+		}
+		if _, ok := ps[p.Name]; ok {
+			return nil, fmt.Errorf("federation: provider %q is configured twice", p.Name)
+		}
+		scopes := p.Scopes
+		if len(scopes) == 0 {
+			scopes = defaultIdentityProviderScopes
+		}
+		ps[p.Name] = &oidcProvider{
+			c:      p,
+			scope:  strings.Join(scopes, " "),
+			client: client,
+			log:    log.NewHelper(logger),
+		}
+	}
+	return ps, nil
+}
+
+// oidcMetadata is the part of the OpenID Provider Metadata a login uses.
+type oidcMetadata struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	UserinfoEndpoint      string   `json:"userinfo_endpoint"`
+	JWKSURI               string   `json:"jwks_uri"`
+	TokenAuthMethods      []string `json:"token_endpoint_auth_methods_supported"`
+}
+
+// oidcProvider is a biz.IdentityProvider logging users in through the
+// authorization code flow of OpenID Connect.
+type oidcProvider struct {
+	c      *conf.Federation_Provider
+	scope  string
+	client *http.Client
+	log    *log.Helper
+
+	mu     sync.Mutex
+	meta   *oidcMetadata
+	keys   map[string]interface{}
+	keysAt time.Time
+}
+
+func (p *oidcProvider) AuthorizationURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return "", err
+	}
+	q := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.c.ClientId},
+		"redirect_uri":          {p.c.RedirectUrl},
+		"scope":                 {p.scope},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {"S256"},
+	}
+	sep := "?"
+	if strings.Contains(meta.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return meta.AuthorizationEndpoint + sep + q.Encode(), nil
+}
+
+func (p *oidcProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*biz.ExternalIdentity, error) {
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.c.RedirectUrl},
+		"code_verifier": {codeVerifier},
+		"client_id":     {p.c.ClientId},
+	}
+	// client_secret_basic is the default of providers not listing methods.
+	basic := p.c.ClientSecret != "" && (len(meta.TokenAuthMethods) == 0 || contains(meta.TokenAuthMethods, "client_secret_basic"))
+	if p.c.ClientSecret != "" && !basic {
+		form.Set("client_secret", p.c.ClientSecret)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if basic {
+		// The credentials are form encoded before the basic encoding.
+		req.SetBasicAuth(url.QueryEscape(p.c.ClientId), url.QueryEscape(p.c.ClientSecret))
+	}
+	var tokens struct {
+		IDToken          string `json:"id_token"`
+		AccessToken      string `json:"access_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := p.do(req, &tokens)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint replied %d: %s %s", status, tokens.Error, tokens.ErrorDescription)
+	}
+	if tokens.IDToken == "" {
+		return nil, fmt.Errorf("token endpoint replied no id_token")
+	}
+	claims, err := p.verify(ctx, tokens.IDToken, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("id_token: %w", err)
+	}
+	if _, ok := claims["email"]; !ok && meta.UserinfoEndpoint != "" && tokens.AccessToken != "" {
+		if err := p.userInfo(ctx, meta.UserinfoEndpoint, tokens.AccessToken, claims); err != nil {
+			return nil, fmt.Errorf("userinfo: %w", err)
+		}
+	}
+	id := &biz.ExternalIdentity{Subject: stringClaim(claims, "sub")}
+	id.Email = stringClaim(claims, "email")
+	id.Name = stringClaim(claims, "name")
+	id.PreferredUsername = stringClaim(claims, "preferred_username")
+	// Some providers send the boolean as a string.
+	switch v := claims["email_verified"].(type) {
+	case bool:
+		id.EmailVerified = v
+	case string:
+		id.EmailVerified = v == "true"
+	}
+	return id, nil
+}
+
+// verify checks the signature and claims of an ID token, and returns its
+// claims.
+func (p *oidcProvider) verify(ctx context.Context, raw, nonce string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	parser := &jwt.Parser{ValidMethods: []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}}
+	_, err := parser.ParseWithClaims(raw, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, err := p.key(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		ok := false
+		switch key.(type) {
+		case *rsa.PublicKey:
+			_, ok = t.Method.(*jwt.SigningMethodRSA)
+			if !ok {
+				_, ok = t.Method.(*jwt.SigningMethodRSAPSS)
+			}
+		case *ecdsa.PublicKey:
+			_, ok = t.Method.(*jwt.SigningMethodECDSA)
+		case ed25519.PublicKey:
+			_, ok = t.Method.(*jwt.SigningMethodEd25519)
+		}
+		if !ok {
+			return nil, fmt.Errorf("key %q does not verify %s", kid, t.Method.Alg())
+		}
+		return key, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case !claims.VerifyIssuer(p.c.Issuer, true):
+		return nil, fmt.Errorf("issuer is not %s", p.c.Issuer)
+	case !claims.VerifyAudience(p.c.ClientId, true):
+		return nil, fmt.Errorf("audience is not %s", p.c.ClientId)
+	case !claims.VerifyExpiresAt(time.Now().Unix(), true):
+		return nil, fmt.Errorf("expired")
+	case stringClaim(claims, "nonce") != nonce:
+		return nil, fmt.Errorf("nonce does not match")
+	case stringClaim(claims, "sub") == "":
+		return nil, fmt.Errorf("no subject")
+	}
+	if azp, ok := claims["azp"]; ok && azp != p.c.ClientId {
+		return nil, fmt.Errorf("authorized party is not %s", p.c.ClientId)
+	}
+	return claims, nil
+}
+
+// userInfo adds the claims of the userinfo endpoint the ID token lacks,
+// if they are about the same subject.
+func (p *oidcProvider) userInfo(ctx context.Context, endpoint, accessToken string, claims jwt.MapClaims) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	info := map[string]interface{}{}
+	status, err := p.do(req, &info)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("replied %d", status)
+	}
+	if info["sub"] != claims["sub"] {
+		return fmt.Errorf("subject does not match the id_token")
+	}
+	for k, v := range info {
+		if _, ok := claims[k]; !ok {
+			claims[k] = v
+		}
+	}
+	return nil
+}
+
+// metadata returns the discovery document of the issuer, fetched once.
+func (p *oidcProvider) metadata(ctx context.Context) (*oidcMetadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.meta != nil {
+		return p.meta, nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(p.c.Issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	var meta oidcMetadata
+	status, err := p.do(req, &meta)
+	if err != nil {
+		return nil, fmt.Errorf("discovery of %s: %w", p.c.Issuer, err)
+	}
+	switch {
+	case status != http.StatusOK:
+		return nil, fmt.Errorf("discovery of %s replied %d", p.c.Issuer, status)
+	case meta.Issuer != p.c.Issuer:
+		return nil, fmt.Errorf("discovery of %s has issuer %s", p.c.Issuer, meta.Issuer)
+	case meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "":
+		return nil, fmt.Errorf("discovery of %s lacks endpoints", p.c.Issuer)
+	}
+	p.meta = &meta
+	return p.meta, nil
+}
+
+// key returns the verification key of kid, or the only key if kid is
+// empty, fetching the JWKS again when it is unknown.
+func (p *oidcProvider) key(ctx context.Context, kid string) (interface{}, error) {
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for fetched := false; ; fetched = true {
+		if k, ok := p.keys[kid]; ok {
+			return k, nil
+		}
+		if kid == "" && len(p.keys) == 1 {
+			for _, k := range p.keys {
+				return k, nil
+			}
+		}
+		if fetched || time.Since(p.keysAt) < jwksRefreshInterval {
+			return nil, fmt.Errorf("unknown key %q", kid)
+		}
+		if err := p.fetchKeys(ctx, meta.JWKSURI); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// fetchKeys replaces the keys by the signature keys of the JWKS. Callers
+// must hold mu.
+func (p *oidcProvider) fetchKeys(ctx context.Context, uri string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return err
+	}
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Use string `json:"use"`
+			Kid string `json:"kid"`
+			Crv string `json:"crv"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		} `json:"keys"`
+	}
+	status, err := p.do(req, &set)
+	if err != nil {
+		return fmt.Errorf("jwks: %w", err)
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("jwks replied %d", status)
+	}
+	keys := make(map[string]interface{}, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		var key interface{}
+		switch {
+		case k.Kty == "RSA":
+			n, e := decodeBigInt(k.N), decodeBigInt(k.E)
+			if n == nil || e == nil || !e.IsInt64() {
+				continue
+			}
+			key = &rsa.PublicKey{N: n, E: int(e.Int64())}
+		case k.Kty == "EC":
+			curve := map[string]elliptic.Curve{"P-256": elliptic.P256(), "P-384": elliptic.P384(), "P-521": elliptic.P521()}[k.Crv]
+			x, y := decodeBigInt(k.X), decodeBigInt(k.Y)
+			if curve == nil || x == nil || y == nil || !curve.IsOnCurve(x, y) {
+				continue
+			}
+			key = &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+		case k.Kty == "OKP" && k.Crv == "Ed25519":
+			x, err := base64.RawURLEncoding.DecodeString(k.X)
+			if err != nil || len(x) != ed25519.PublicKeySize {
+				continue
+			}
+			key = ed25519.PublicKey(x)
+		default:
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	p.keys, p.keysAt = keys, time.Now()
+	p.log.WithContext(ctx).Infof("fetched %d keys of %s", len(keys), p.c.Issuer)
+	return nil
+}
+
+// do sends a request and decodes its JSON reply into v, whatever its
+// status.
+func (p *oidcProvider) do(req *http.Request, v interface{}) (int, error) {
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(io.LimitReader(resp.Body, identityProviderMaxBody)).Decode(v); err != nil {
+		return resp.StatusCode, fmt.Errorf("decode reply %d: %w", resp.StatusCode, err)
+	}
+	return resp.StatusCode, nil
+}
+
+func decodeBigInt(s string) *big.Int {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) == 0 {
+		return nil
+	}
+	return new(big.Int).SetBytes(b)
+}
+
+func stringClaim(claims jwt.MapClaims, name string) string {
+	s, _ := claims[name].(string)
+	return s
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
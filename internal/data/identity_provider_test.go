@@ -0,0 +1,352 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"user/internal/biz"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	testClientID     = "client"
+	testClientSecret = "s3cret"
+	testRedirectURL  = "http://app.local/callback"
+	testCode         = "code-1"
+	testVerifier     = "verifier-1"
+	testNonce        = "nonce-1"
+)
+
+// testIssuer is a local stand-in OpenID Connect provider. Its token
+// endpoint answers testCode with an ID token of the claims of idClaims,
+// signed by key.
+type testIssuer struct {
+	*httptest.Server
+	key   *rsa.PrivateKey
+	keyID string
+
+	mu sync.Mutex
+	// metaIssuer overrides the issuer of the discovery document.
+	metaIssuer string
+	// authMethods are the token endpoint auth methods of the discovery
+	// document.
+	authMethods []string
+	// idClaims edits the claims of the ID token; nil values delete them.
+	idClaims jwt.MapClaims
+	// signer signs the ID token instead of key, with another key id.
+	signer *rsa.PrivateKey
+	// userinfo is served by the userinfo endpoint.
+	userinfo map[string]interface{}
+	// form and basic are the last request of the token endpoint.
+	form  url.Values
+	basic bool
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iss := &testIssuer{key: key, keyID: "k1"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", iss.discovery)
+	mux.HandleFunc("/jwks", iss.jwks)
+	mux.HandleFunc("/token", iss.token)
+	mux.HandleFunc("/userinfo", iss.userInfo)
+	iss.Server = httptest.NewServer(mux)
+	t.Cleanup(iss.Close)
+	return iss
+}
+
+func (iss *testIssuer) discovery(w http.ResponseWriter, r *http.Request) {
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+	issuer := iss.URL
+	if iss.metaIssuer != "" {
+		issuer = iss.metaIssuer
+	}
+	writeTestJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                issuer,
+		"authorization_endpoint":                iss.URL + "/authorize?tenant=1",
+		"token_endpoint":                        iss.URL + "/token",
+		"userinfo_endpoint":                     iss.URL + "/userinfo",
+		"jwks_uri":                              iss.URL + "/jwks",
+		"token_endpoint_auth_methods_supported": iss.authMethods,
+	})
+}
+
+func (iss *testIssuer) jwks(w http.ResponseWriter, r *http.Request) {
+	pub := iss.key.PublicKey
+	writeTestJSON(w, http.StatusOK, map[string]interface{}{"keys": []map[string]string{
+		{"kty": "RSA", "use": "enc", "kid": "enc", "n": "AQAB", "e": "AQAB"},
+		{
+			"kty": "RSA",
+			"use": "sig",
+			"kid": iss.keyID,
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		},
+	}})
+}
+
+func (iss *testIssuer) token(w http.ResponseWriter, r *http.Request) {
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+	if err := r.ParseForm(); err != nil {
+		writeTestJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	iss.form = r.PostForm
+	id, secret, basic := r.BasicAuth()
+	iss.basic = basic
+	if !basic {
+		id, secret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if id != testClientID || secret != testClientSecret {
+		writeTestJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+	if r.PostForm.Get("code") != testCode || r.PostForm.Get("code_verifier") != testVerifier || r.PostForm.Get("redirect_uri") != testRedirectURL {
+		writeTestJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "bad code"})
+		return
+	}
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":            iss.URL,
+		"sub":            "u1",
+		"aud":            testClientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Minute).Unix(),
+		"nonce":          testNonce,
+		"email":          "jane@example.com",
+		"email_verified": true,
+		"name":           "Jane",
+	}
+	for k, v := range iss.idClaims {
+		if v == nil {
+			delete(claims, k)
+		} else {
+			claims[k] = v
+		}
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	key := iss.key
+	token.Header["kid"] = iss.keyID
+	if iss.signer != nil {
+		key = iss.signer
+		token.Header["kid"] = "other"
+	}
+	idToken, err := token.SignedString(key)
+	if err != nil {
+		writeTestJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+	writeTestJSON(w, http.StatusOK, map[string]string{"id_token": idToken, "access_token": "at-1", "token_type": "Bearer"})
+}
+
+func (iss *testIssuer) userInfo(w http.ResponseWriter, r *http.Request) {
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+	if r.Header.Get("Authorization") != "Bearer at-1" || iss.userinfo == nil {
+		writeTestJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_token"})
+		return
+	}
+	writeTestJSON(w, http.StatusOK, iss.userinfo)
+}
+
+func writeTestJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// provider returns the identity provider of the issuer.
+func (iss *testIssuer) provider(t *testing.T) biz.IdentityProvider {
+	t.Helper()
+	ps, err := NewIdentityProviders(&conf.Federation{Providers: []*conf.Federation_Provider{{
+		Name:         "test",
+		Issuer:       iss.URL,
+		ClientId:     testClientID,
+		ClientSecret: testClientSecret,
+		RedirectUrl:  testRedirectURL,
+	}}}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ps["test"]
+}
+
+func TestNewIdentityProvidersChecksConfig(t *testing.T) {
+	for name, ps := range map[string][]*conf.Federation_Provider{
+		"no name":    {{Issuer: "https://id.example.com", ClientId: "c", RedirectUrl: "https://app/cb"}},
+		"no issuer":  {{Name: "a", ClientId: "c", RedirectUrl: "https://app/cb"}},
+		"duplicated": {{Name: "a", Issuer: "https://a", ClientId: "c", RedirectUrl: "https://app/cb"}, {Name: "a", Issuer: "https://b", ClientId: "c", RedirectUrl: "https://app/cb"}},
+	} {
+		if _, err := NewIdentityProviders(&conf.Federation{Providers: ps}, log.DefaultLogger); err == nil {
+			t.Errorf("%s: NewIdentityProviders() = nil error", name)
+		}
+	}
+}
+
+func TestOIDCProviderAuthorizationURL(t *testing.T) {
+	iss := newTestIssuer(t)
+	to, err := iss.provider(t).AuthorizationURL(context.Background(), "state-1", testNonce, "challenge-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := u.Scheme + "://" + u.Host + u.Path; got != iss.URL+"/authorize" {
+		t.Errorf("endpoint = %s, want %s/authorize", got, iss.URL)
+	}
+	want := map[string]string{
+		"tenant":                "1",
+		"response_type":         "code",
+		"client_id":             testClientID,
+		"redirect_uri":          testRedirectURL,
+		"scope":                 "openid email profile",
+		"state":                 "state-1",
+		"nonce":                 testNonce,
+		"code_challenge":        "challenge-1",
+		"code_challenge_method": "S256",
+	}
+	for k, v := range want {
+		if got := u.Query().Get(k); got != v {
+			t.Errorf("%s = %q, want %q", k, got, v)
+		}
+	}
+}
+
+func TestOIDCProviderDiscoveryChecksIssuer(t *testing.T) {
+	iss := newTestIssuer(t)
+	iss.metaIssuer = "https://evil.example.com"
+	if _, err := iss.provider(t).AuthorizationURL(context.Background(), "s", "n", "c"); err == nil {
+		t.Error("AuthorizationURL() = nil error for a discovery document of another issuer")
+	}
+}
+
+func TestOIDCProviderExchange(t *testing.T) {
+	iss := newTestIssuer(t)
+	id, err := iss.provider(t).Exchange(context.Background(), testCode, testVerifier, testNonce)
+	if err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+	want := biz.ExternalIdentity{Subject: "u1", Email: "jane@example.com", EmailVerified: true, Name: "Jane"}
+	if *id != want {
+		t.Errorf("Exchange() = %+v, want %+v", *id, want)
+	}
+	if !iss.basic {
+		t.Error("the client did not authenticate with client_secret_basic")
+	}
+}
+
+func TestOIDCProviderExchangeClientSecretPost(t *testing.T) {
+	iss := newTestIssuer(t)
+	iss.authMethods = []string{"client_secret_post"}
+	if _, err := iss.provider(t).Exchange(context.Background(), testCode, testVerifier, testNonce); err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+	if iss.basic || iss.form.Get("client_secret") != testClientSecret {
+		t.Error("the client did not authenticate with client_secret_post")
+	}
+}
+
+func TestOIDCProviderExchangeUserInfo(t *testing.T) {
+	iss := newTestIssuer(t)
+	iss.idClaims = jwt.MapClaims{"email": nil, "email_verified": nil}
+	iss.userinfo = map[string]interface{}{"sub": "u1", "email": "jane@example.com", "email_verified": "true", "preferred_username": "jdoe"}
+	id, err := iss.provider(t).Exchange(context.Background(), testCode, testVerifier, testNonce)
+	if err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+	if id.Email != "jane@example.com" || !id.EmailVerified || id.PreferredUsername != "jdoe" {
+		t.Errorf("Exchange() = %+v, want the claims of the userinfo endpoint", id)
+	}
+
+	iss.userinfo["sub"] = "u2"
+	if _, err := iss.provider(t).Exchange(context.Background(), testCode, testVerifier, testNonce); err == nil {
+		t.Error("Exchange() = nil error for userinfo of another subject")
+	}
+}
+
+func TestOIDCProviderExchangeRejects(t *testing.T) {
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		claims   jwt.MapClaims
+		signer   *rsa.PrivateKey
+		code     string
+		verifier string
+		nonce    string
+	}{
+		{name: "bad issuer", claims: jwt.MapClaims{"iss": "https://evil.example.com"}},
+		{name: "bad audience", claims: jwt.MapClaims{"aud": "other-client"}},
+		{name: "bad authorized party", claims: jwt.MapClaims{"azp": "other-client"}},
+		{name: "bad nonce", nonce: "nonce-2"},
+		{name: "no nonce", claims: jwt.MapClaims{"nonce": nil}},
+		{name: "expired", claims: jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()}},
+		{name: "no expiry", claims: jwt.MapClaims{"exp": nil}},
+		{name: "no subject", claims: jwt.MapClaims{"sub": nil}},
+		{name: "unknown key", signer: other},
+		{name: "bad code", code: "code-2"},
+		{name: "bad verifier", verifier: "verifier-2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iss := newTestIssuer(t)
+			iss.idClaims, iss.signer = tt.claims, tt.signer
+			code, verifier, nonce := testCode, testVerifier, testNonce
+			if tt.code != "" {
+				code = tt.code
+			}
+			if tt.verifier != "" {
+				verifier = tt.verifier
+			}
+			if tt.nonce != "" {
+				nonce = tt.nonce
+			}
+			id, err := iss.provider(t).Exchange(context.Background(), code, verifier, nonce)
+			if err == nil {
+				t.Errorf("Exchange() = %+v, want an error", id)
+			}
+		})
+	}
+}
+
+func TestOIDCProviderRejectsUnsignedTokens(t *testing.T) {
+	iss := newTestIssuer(t)
+	p := iss.provider(t).(*oidcProvider)
+	claims := jwt.MapClaims{"iss": iss.URL, "aud": testClientID, "sub": "u1", "nonce": testNonce, "exp": time.Now().Add(time.Minute).Unix()}
+	for name, method := range map[string]jwt.SigningMethod{"none": jwt.SigningMethodNone, "HS256": jwt.SigningMethodHS256} {
+		key := interface{}(jwt.UnsafeAllowNoneSignatureType)
+		if method == jwt.SigningMethodHS256 {
+			key = []byte(strings.Repeat("k", 32))
+		}
+		raw, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := p.verify(context.Background(), raw, testNonce); err == nil {
+			t.Errorf("verify() accepted a token signed with %s", name)
+		}
+	}
+}
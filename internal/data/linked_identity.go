@@ -0,0 +1,102 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const linkedIdentityColumns = "user_id, provider, subject, email, created_at, last_login_at"
+
+type linkedIdentityRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewLinkedIdentityRepo .
+func NewLinkedIdentityRepo(data *Data, logger log.Logger) biz.LinkedIdentityRepo {
+	if data.mem != nil {
+		return &memoryLinkedIdentityRepo{data: data}
+	}
+	return &linkedIdentityRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *linkedIdentityRepo) Save(ctx context.Context, li *biz.LinkedIdentity) (*biz.LinkedIdentity, error) {
+	nli := *li
+	nli.CreatedAt = now()
+	nli.LastLoginAt = nli.CreatedAt
+	_, err := r.data.conn(ctx).ExecContext(ctx,
+		"INSERT INTO linked_identities ("+linkedIdentityColumns+") VALUES (?, ?, ?, ?, ?, ?)",
+		nli.UserID, nli.Provider, nli.Subject, nli.Email, nli.CreatedAt, nli.LastLoginAt)
+	if isDuplicate(err) {
+		return nil, biz.ErrFederatedAccountConflict
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &nli, nil
+}
+
+func (r *linkedIdentityRepo) Find(ctx context.Context, provider, subject string) (*biz.LinkedIdentity, error) {
+	li, err := scanLinkedIdentity(r.data.conn(ctx).QueryRowContext(ctx,
+		"SELECT "+linkedIdentityColumns+" FROM linked_identities WHERE provider = ? AND subject = ?", provider, subject))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrLinkedIdentityNotFound
+	}
+	return li, err
+}
+
+func (r *linkedIdentityRepo) List(ctx context.Context, userID int64) ([]*biz.LinkedIdentity, error) {
+	rows, err := r.data.conn(ctx).QueryContext(ctx,
+		"SELECT "+linkedIdentityColumns+" FROM linked_identities WHERE user_id = ? ORDER BY provider", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ids []*biz.LinkedIdentity
+	for rows.Next() {
+		li, err := scanLinkedIdentity(rows)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, li)
+	}
+	return ids, rows.Err()
+}
+
+func (r *linkedIdentityRepo) Touch(ctx context.Context, provider, subject, email string, at time.Time) error {
+	_, err := r.data.conn(ctx).ExecContext(ctx,
+		"UPDATE linked_identities SET email = ?, last_login_at = ? WHERE provider = ? AND subject = ?",
+		email, at.UTC().Truncate(time.Microsecond), provider, subject)
+	return err
+}
+
+func (r *linkedIdentityRepo) Delete(ctx context.Context, userID int64, provider string) error {
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"DELETE FROM linked_identities WHERE user_id = ? AND provider = ?", userID, provider)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return biz.ErrLinkedIdentityNotFound
+	}
+	return nil
+}
+
+func scanLinkedIdentity(row scanner) (*biz.LinkedIdentity, error) {
+	var li biz.LinkedIdentity
+	err := row.Scan(&li.UserID, &li.Provider, &li.Subject, &li.Email, &li.CreatedAt, &li.LastLoginAt)
+	if err != nil {
+		return nil, err
+	}
+	return &li, nil
+}
@@ -0,0 +1,95 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"user/internal/biz"
+)
+
+// identityKey is the key of a linked identity in the memory driver.
+type identityKey struct {
+	provider, subject string
+}
+
+// memoryLinkedIdentityRepo is the biz.LinkedIdentityRepo of the memory
+// driver.
+type memoryLinkedIdentityRepo struct {
+	data *Data
+}
+
+func (r *memoryLinkedIdentityRepo) Save(ctx context.Context, li *biz.LinkedIdentity) (*biz.LinkedIdentity, error) {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	if _, ok := m.users[li.UserID]; !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	k := identityKey{li.Provider, li.Subject}
+	if _, ok := m.linkedIdentities[k]; ok {
+		return nil, biz.ErrFederatedAccountConflict
+	}
+	for _, o := range m.linkedIdentities {
+		if o.UserID == li.UserID && o.Provider == li.Provider {
+			return nil, biz.ErrFederatedAccountConflict
+		}
+	}
+	nli := *li
+	nli.CreatedAt = now()
+	nli.LastLoginAt = nli.CreatedAt
+	m.linkedIdentities[k] = &nli
+	c := nli
+	return &c, nil
+}
+
+func (r *memoryLinkedIdentityRepo) Find(ctx context.Context, provider, subject string) (*biz.LinkedIdentity, error) {
+	m := r.data.mem
+	defer m.rlock(ctx)()
+	li, ok := m.linkedIdentities[identityKey{provider, subject}]
+	if !ok {
+		return nil, biz.ErrLinkedIdentityNotFound
+	}
+	c := *li
+	return &c, nil
+}
+
+func (r *memoryLinkedIdentityRepo) List(ctx context.Context, userID int64) ([]*biz.LinkedIdentity, error) {
+	m := r.data.mem
+	defer m.rlock(ctx)()
+	var ids []*biz.LinkedIdentity
+	for _, li := range m.linkedIdentities {
+		if li.UserID == userID {
+			c := *li
+			ids = append(ids, &c)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].Provider < ids[j].Provider })
+	return ids, nil
+}
+
+func (r *memoryLinkedIdentityRepo) Touch(ctx context.Context, provider, subject, email string, at time.Time) error {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	k := identityKey{provider, subject}
+	li, ok := m.linkedIdentities[k]
+	if !ok {
+		return nil
+	}
+	nli := *li
+	nli.Email = email
+	nli.LastLoginAt = at.UTC().Truncate(time.Microsecond)
+	m.linkedIdentities[k] = &nli
+	return nil
+}
+
+func (r *memoryLinkedIdentityRepo) Delete(ctx context.Context, userID int64, provider string) error {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	for k, li := range m.linkedIdentities {
+		if li.UserID == userID && li.Provider == provider {
+			delete(m.linkedIdentities, k)
+			return nil
+		}
+	}
+	return biz.ErrLinkedIdentityNotFound
+}
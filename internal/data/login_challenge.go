@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type loginChallengeRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewLoginChallengeRepo .
+func NewLoginChallengeRepo(data *Data, logger log.Logger) biz.LoginChallengeRepo {
+	if data.mem != nil {
+		return &memoryLoginChallengeRepo{data: data}
+	}
+	return &loginChallengeRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *loginChallengeRepo) Save(ctx context.Context, c *biz.LoginChallenge) error {
+	_, err := r.data.conn(ctx).ExecContext(ctx,
+		"INSERT INTO login_challenges (token_hash, user_id, attempts, created_at, expires_at) VALUES (?, ?, 0, ?, ?)",
+		c.TokenHash, c.UserID, now(), c.ExpiresAt.UTC().Truncate(time.Microsecond))
+	return err
+}
+
+func (r *loginChallengeRepo) Find(ctx context.Context, hash string) (*biz.LoginChallenge, error) {
+	var c biz.LoginChallenge
+	err := r.data.conn(ctx).QueryRowContext(ctx,
+		"SELECT token_hash, user_id, attempts, created_at, expires_at FROM login_challenges WHERE token_hash = ?", hash).
+		Scan(&c.TokenHash, &c.UserID, &c.Attempts, &c.CreatedAt, &c.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrChallengeInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *loginChallengeRepo) AddAttempt(ctx context.Context, hash string) (int32, error) {
+	var n int32
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		_, err := r.data.conn(ctx).ExecContext(ctx,
+			"UPDATE login_challenges SET attempts = attempts + 1 WHERE token_hash = ?", hash)
+		if err != nil {
+			return err
+		}
+		err = r.data.conn(ctx).QueryRowContext(ctx,
+			"SELECT attempts FROM login_challenges WHERE token_hash = ?", hash).Scan(&n)
+		if errors.Is(err, sql.ErrNoRows) {
+			return biz.ErrChallengeInvalid
+		}
+		return err
+	})
+	return n, err
+}
+
+// Delete also purges the expired challenges, which are otherwise only
+// removed when used.
+func (r *loginChallengeRepo) Delete(ctx context.Context, hash string) error {
+	_, err := r.data.conn(ctx).ExecContext(ctx,
+		"DELETE FROM login_challenges WHERE token_hash = ? OR expires_at < ?", hash, now())
+	return err
+}
@@ -0,0 +1,65 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"user/internal/biz"
+)
+
+// memoryLoginChallengeRepo is the biz.LoginChallengeRepo of the memory
+// driver.
+type memoryLoginChallengeRepo struct {
+	data *Data
+}
+
+func (r *memoryLoginChallengeRepo) Save(ctx context.Context, c *biz.LoginChallenge) error {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	if _, ok := s.users[c.UserID]; !ok {
+		return biz.ErrUserNotFound
+	}
+	nc := *c
+	nc.Attempts, nc.CreatedAt = 0, now()
+	nc.ExpiresAt = c.ExpiresAt.UTC().Truncate(time.Microsecond)
+	s.loginChallenges[nc.TokenHash] = &nc
+	return nil
+}
+
+func (r *memoryLoginChallengeRepo) Find(ctx context.Context, hash string) (*biz.LoginChallenge, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	c, ok := s.loginChallenges[hash]
+	if !ok {
+		return nil, biz.ErrChallengeInvalid
+	}
+	nc := *c
+	return &nc, nil
+}
+
+func (r *memoryLoginChallengeRepo) AddAttempt(ctx context.Context, hash string) (int32, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	c, ok := s.loginChallenges[hash]
+	if !ok {
+		return 0, biz.ErrChallengeInvalid
+	}
+	nc := *c
+	nc.Attempts++
+	s.loginChallenges[hash] = &nc
+	return nc.Attempts, nil
+}
+
+// Delete also purges the expired challenges, which are otherwise only
+// removed when used.
+func (r *memoryLoginChallengeRepo) Delete(ctx context.Context, hash string) error {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	t := now()
+	for k, c := range s.loginChallenges {
+		if k == hash || c.ExpiresAt.Before(t) {
+			delete(s.loginChallenges, k)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"user/internal/biz"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewMailer returns the biz.Mailer of the mail driver.
+func NewMailer(c *conf.Mail, logger log.Logger) (biz.Mailer, error) {
+	from := c.GetFrom()
+	if from == "" {
+		from = "no-reply@localhost"
+	}
+	switch c.GetDriver() {
+	case "smtp":
+		if c.GetSmtp().GetAddr() == "" {
+			return nil, fmt.Errorf("mail: smtp.addr is required by the smtp driver")
+		}
+		return &smtpMailer{c: c.GetSmtp(), from: from}, nil
+	case "file":
+		if c.GetDir() == "" {
+			return nil, fmt.Errorf("mail: dir is required by the file driver")
+		}
+		if err := os.MkdirAll(c.GetDir(), 0o700); err != nil {
+			return nil, err
+		}
+		return &fileMailer{dir: c.GetDir(), from: from}, nil
+	case "", "log":
+		return &logMailer{from: from, log: log.NewHelper(logger)}, nil
+	default:
+		return nil, fmt.Errorf("mail: unknown driver %q", c.GetDriver())
+	}
+}
+
+// smtpMailer sends mails through an SMTP server.
+type smtpMailer struct {
+	c    *conf.Mail_SMTP
+	from string
+}
+
+func (m *smtpMailer) Send(ctx context.Context, mail *biz.Mail) error {
+	var auth smtp.Auth
+	if m.c.Username != "" {
+		host := m.c.Addr
+		if i := strings.LastIndex(host, ":"); i >= 0 {
+			host = host[:i]
+		}
+		auth = smtp.PlainAuth("", m.c.Username, m.c.Password, host)
+	}
+	msg, err := formatMail(m.from, mail)
+	if err != nil {
+		return err
+	}
+	return smtp.SendMail(m.c.Addr, auth, m.from, []string{mail.To}, msg)
+}
+
+// fileMailer writes every mail to a file of dir, for local runs.
+type fileMailer struct {
+	dir  string
+	from string
+}
+
+func (m *fileMailer) Send(ctx context.Context, mail *biz.Mail) error {
+	msg, err := formatMail(m.from, mail)
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405.000000"), mail.To)
+	return os.WriteFile(filepath.Join(m.dir, filepath.Base(name)), msg, 0o600)
+}
+
+// logMailer logs every mail, for local runs.
+type logMailer struct {
+	from string
+	log  *log.Helper
+}
+
+func (m *logMailer) Send(ctx context.Context, mail *biz.Mail) error {
+	m.log.WithContext(ctx).Infof("mail from %s to %s: %s\n%s", m.from, mail.To, mail.Subject, mail.Body)
+	return nil
+}
+
+// formatMail returns the RFC 5322 message of a plain text mail.
+func formatMail(from string, mail *biz.Mail) ([]byte, error) {
+	if strings.ContainsAny(mail.To, "\r\n") {
+		return nil, fmt.Errorf("mail: invalid recipient %q", mail.To)
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	domain := from[strings.LastIndex(from, "@")+1:]
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", mail.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", mail.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "Message-ID: <%s@%s>\r\n", hex.EncodeToString(id), domain)
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(mail.Body, "\n", "\r\n"))
+	return []byte(b.String()), nil
+}
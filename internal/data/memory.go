@@ -0,0 +1,230 @@
+package data
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"user/internal/biz"
+)
+
+// memoryDriver selects the in-memory store instead of a SQL database.
+const memoryDriver = "memory"
+
+type memoryTxKey struct{}
+
+// memoryStore holds every table of the memory driver. A single lock guards
+// all tables so that repos can keep cross-table invariants the same way a
+// SQL transaction would. Stored rows are never modified in place, writers
+// replace them with a fresh copy, so a snapshot only copies the maps.
+type memoryStore struct {
+	mu  sync.RWMutex
+	seq map[string]int64
+
+	users          map[int64]*biz.User
+	credentials    map[int64]*biz.Credential
+	refreshTokens  map[int64]*biz.RefreshToken
+	follows        map[edgeKey]*biz.Follow
+	blocks         map[edgeKey]*biz.Block
+	mutes          map[edgeKey]*biz.Mute
+	followRequests map[edgeKey]*biz.FollowRequest
+	handleChanges  map[int64]*biz.HandleChange
+	// emailVerifications is keyed by user id.
+	emailVerifications map[int64]*biz.EmailVerification
+	// passwordResets is keyed by user id.
+	passwordResets map[int64]*biz.PasswordReset
+	// totps is keyed by user id, recoveryCodes hold their creation time and
+	// loginChallenges are keyed by token hash.
+	totps           map[int64]*biz.TOTP
+	recoveryCodes   map[recoveryCodeKey]time.Time
+	loginChallenges map[string]*biz.LoginChallenge
+	// sessions is keyed by session id.
+	sessions map[string]*biz.Session
+	// oauthClients are keyed by client id and authorizationCodes by code
+	// hash.
+	oauthClients       map[string]*biz.OAuthClient
+	authorizationCodes map[string]*biz.AuthorizationCode
+	// linkedIdentities are keyed by provider and subject, and
+	// federatedLogins by state hash.
+	linkedIdentities map[identityKey]*biz.LinkedIdentity
+	federatedLogins  map[string]*biz.FederatedLogin
+	apiKeys          map[int64]*biz.APIKey
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		seq:                make(map[string]int64),
+		users:              make(map[int64]*biz.User),
+		credentials:        make(map[int64]*biz.Credential),
+		refreshTokens:      make(map[int64]*biz.RefreshToken),
+		follows:            make(map[edgeKey]*biz.Follow),
+		blocks:             make(map[edgeKey]*biz.Block),
+		mutes:              make(map[edgeKey]*biz.Mute),
+		followRequests:     make(map[edgeKey]*biz.FollowRequest),
+		handleChanges:      make(map[int64]*biz.HandleChange),
+		emailVerifications: make(map[int64]*biz.EmailVerification),
+		passwordResets:     make(map[int64]*biz.PasswordReset),
+		totps:              make(map[int64]*biz.TOTP),
+		recoveryCodes:      make(map[recoveryCodeKey]time.Time),
+		loginChallenges:    make(map[string]*biz.LoginChallenge),
+		sessions:           make(map[string]*biz.Session),
+		oauthClients:       make(map[string]*biz.OAuthClient),
+		authorizationCodes: make(map[string]*biz.AuthorizationCode),
+		linkedIdentities:   make(map[identityKey]*biz.LinkedIdentity),
+		federatedLogins:    make(map[string]*biz.FederatedLogin),
+		apiKeys:            make(map[int64]*biz.APIKey),
+	}
+}
+
+// nextID returns the next auto-increment id of table. Callers must hold
+// the write lock.
+func (s *memoryStore) nextID(table string) int64 {
+	s.seq[table]++
+	return s.seq[table]
+}
+
+// lock acquires the write lock and returns its release, unless ctx runs in
+// a transaction of s, which already holds it.
+func (s *memoryStore) lock(ctx context.Context) func() {
+	if ctx.Value(memoryTxKey{}) == s {
+		return func() {}
+	}
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+// rlock is lock for readers.
+func (s *memoryStore) rlock(ctx context.Context) func() {
+	if ctx.Value(memoryTxKey{}) == s {
+		return func() {}
+	}
+	s.mu.RLock()
+	return s.mu.RUnlock
+}
+
+// execTx runs fn holding the write lock for its whole duration, and
+// restores every table if it fails.
+func (s *memoryStore) execTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx.Value(memoryTxKey{}) == s {
+		return fn(ctx)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snap := s.snapshot()
+	if err := fn(context.WithValue(ctx, memoryTxKey{}, s)); err != nil {
+		s.restore(snap)
+		return err
+	}
+	return nil
+}
+
+// snapshot copies the tables. Callers must hold the write lock.
+func (s *memoryStore) snapshot() *memoryStore {
+	c := &memoryStore{
+		seq:                make(map[string]int64, len(s.seq)),
+		users:              make(map[int64]*biz.User, len(s.users)),
+		credentials:        make(map[int64]*biz.Credential, len(s.credentials)),
+		refreshTokens:      make(map[int64]*biz.RefreshToken, len(s.refreshTokens)),
+		follows:            make(map[edgeKey]*biz.Follow, len(s.follows)),
+		blocks:             make(map[edgeKey]*biz.Block, len(s.blocks)),
+		mutes:              make(map[edgeKey]*biz.Mute, len(s.mutes)),
+		followRequests:     make(map[edgeKey]*biz.FollowRequest, len(s.followRequests)),
+		handleChanges:      make(map[int64]*biz.HandleChange, len(s.handleChanges)),
+		emailVerifications: make(map[int64]*biz.EmailVerification, len(s.emailVerifications)),
+		passwordResets:     make(map[int64]*biz.PasswordReset, len(s.passwordResets)),
+		totps:              make(map[int64]*biz.TOTP, len(s.totps)),
+		recoveryCodes:      make(map[recoveryCodeKey]time.Time, len(s.recoveryCodes)),
+		loginChallenges:    make(map[string]*biz.LoginChallenge, len(s.loginChallenges)),
+		sessions:           make(map[string]*biz.Session, len(s.sessions)),
+		oauthClients:       make(map[string]*biz.OAuthClient, len(s.oauthClients)),
+		authorizationCodes: make(map[string]*biz.AuthorizationCode, len(s.authorizationCodes)),
+		linkedIdentities:   make(map[identityKey]*biz.LinkedIdentity, len(s.linkedIdentities)),
+		federatedLogins:    make(map[string]*biz.FederatedLogin, len(s.federatedLogins)),
+		apiKeys:            make(map[int64]*biz.APIKey, len(s.apiKeys)),
+	}
+	for k, v := range s.seq {
+		c.seq[k] = v
+	}
+	for k, v := range s.users {
+		c.users[k] = v
+	}
+	for k, v := range s.credentials {
+		c.credentials[k] = v
+	}
+	for k, v := range s.refreshTokens {
+		c.refreshTokens[k] = v
+	}
+	for k, v := range s.follows {
+		c.follows[k] = v
+	}
+	for k, v := range s.blocks {
+		c.blocks[k] = v
+	}
+	for k, v := range s.mutes {
+		c.mutes[k] = v
+	}
+	for k, v := range s.followRequests {
+		c.followRequests[k] = v
+	}
+	for k, v := range s.handleChanges {
+		c.handleChanges[k] = v
+	}
+	for k, v := range s.emailVerifications {
+		c.emailVerifications[k] = v
+	}
+	for k, v := range s.passwordResets {
+		c.passwordResets[k] = v
+	}
+	for k, v := range s.totps {
+		c.totps[k] = v
+	}
+	for k, v := range s.recoveryCodes {
+		c.recoveryCodes[k] = v
+	}
+	for k, v := range s.loginChallenges {
+		c.loginChallenges[k] = v
+	}
+	for k, v := range s.sessions {
+		c.sessions[k] = v
+	}
+	for k, v := range s.oauthClients {
+		c.oauthClients[k] = v
+	}
+	for k, v := range s.authorizationCodes {
+		c.authorizationCodes[k] = v
+	}
+	for k, v := range s.linkedIdentities {
+		c.linkedIdentities[k] = v
+	}
+	for k, v := range s.federatedLogins {
+		c.federatedLogins[k] = v
+	}
+	for k, v := range s.apiKeys {
+		c.apiKeys[k] = v
+	}
+	return c
+}
+
+// restore puts back the tables of snap. Callers must hold the write lock.
+func (s *memoryStore) restore(snap *memoryStore) {
+	s.seq = snap.seq
+	s.users = snap.users
+	s.credentials = snap.credentials
+	s.refreshTokens = snap.refreshTokens
+	s.follows = snap.follows
+	s.blocks = snap.blocks
+	s.mutes = snap.mutes
+	s.followRequests = snap.followRequests
+	s.handleChanges = snap.handleChanges
+	s.emailVerifications = snap.emailVerifications
+	s.passwordResets = snap.passwordResets
+	s.totps = snap.totps
+	s.recoveryCodes = snap.recoveryCodes
+	s.loginChallenges = snap.loginChallenges
+	s.sessions = snap.sessions
+	s.oauthClients = snap.oauthClients
+	s.authorizationCodes = snap.authorizationCodes
+	s.linkedIdentities = snap.linkedIdentities
+	s.federatedLogins = snap.federatedLogins
+	s.apiKeys = snap.apiKeys
+}
@@ -0,0 +1,347 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"user/internal/biz"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Migrations live in migrations/<dialect>/ as pairs of
+// <version>_<name>.up.sql and <version>_<name>.down.sql files. Versions are
+// applied in ascending order and recorded in the schema_migrations table.
+//
+//go:embed migrations
+var migrationFS embed.FS
+
+// migrationFuncs are the steps of migrations that SQL cannot express. They
+// run in the transaction of their migration, after its up script.
+var migrationFuncs = map[int64]func(ctx context.Context, tx *sql.Tx) error{
+	18: rekeyUsernames,
+}
+
+const migrationTable = "schema_migrations"
+
+type migration struct {
+	version int64
+	name    string
+	up      string
+	down    string
+}
+
+// MigrationStatus is the state of one migration in the database.
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// Migrator applies and rolls back the embedded schema migrations.
+type Migrator struct {
+	db         *sql.DB
+	migrations []*migration
+	log        *log.Helper
+}
+
+// NewMigrator opens the configured database for schema management.
+func NewMigrator(c *conf.Data, logger log.Logger) (*Migrator, func(), error) {
+	db, dialect, err := openDB(c.Database)
+	if err != nil {
+		return nil, nil, err
+	}
+	m, err := newMigrator(db, dialect, logger)
+	if err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+	return m, func() { db.Close() }, nil
+}
+
+func newMigrator(db *sql.DB, dialect string, logger log.Logger) (*Migrator, error) {
+	ms, err := loadMigrations(dialect)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: ms, log: log.NewHelper(logger)}, nil
+}
+
+func loadMigrations(dialect string) ([]*migration, error) {
+	dir := path.Join("migrations", dialect)
+	ups, err := fs.Glob(migrationFS, dir+"/*.up.sql")
+	if err != nil {
+		return nil, err
+	}
+	if len(ups) == 0 {
+		return nil, fmt.Errorf("data: no migrations for dialect %q", dialect)
+	}
+	ms := make([]*migration, 0, len(ups))
+	for _, up := range ups {
+		base := strings.TrimSuffix(path.Base(up), ".up.sql")
+		i := strings.IndexByte(base, '_')
+		if i < 0 {
+			return nil, fmt.Errorf("data: malformed migration file name %q", up)
+		}
+		version, err := strconv.ParseInt(base[:i], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("data: malformed migration version in %q", up)
+		}
+		upSQL, err := migrationFS.ReadFile(up)
+		if err != nil {
+			return nil, err
+		}
+		downSQL, err := migrationFS.ReadFile(path.Join(dir, base+".down.sql"))
+		if err != nil {
+			return nil, fmt.Errorf("data: migration %d has no down file: %w", version, err)
+		}
+		ms = append(ms, &migration{version: version, name: base[i+1:], up: string(upSQL), down: string(downSQL)})
+	}
+	sort.Slice(ms, func(i, j int) bool { return ms[i].version < ms[j].version })
+	for i := 1; i < len(ms); i++ {
+		if ms[i].version == ms[i-1].version {
+			return nil, fmt.Errorf("data: duplicate migration version %d", ms[i].version)
+		}
+	}
+	return ms, nil
+}
+
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+migrationTable+
+		" (version BIGINT NOT NULL PRIMARY KEY, name VARCHAR(255) NOT NULL, applied_at DATETIME NOT NULL)")
+	return err
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int64]time.Time, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+	rows, err := m.db.QueryContext(ctx, "SELECT version, applied_at FROM "+migrationTable)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	applied := make(map[int64]time.Time)
+	for rows.Next() {
+		var (
+			v  int64
+			at time.Time
+		)
+		if err := rows.Scan(&v, &at); err != nil {
+			return nil, err
+		}
+		applied[v] = at
+	}
+	return applied, rows.Err()
+}
+
+// Status reports every known migration and whether it has been applied.
+func (m *Migrator) Status(ctx context.Context) ([]*MigrationStatus, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ss := make([]*MigrationStatus, 0, len(m.migrations))
+	for _, mg := range m.migrations {
+		at, ok := applied[mg.version]
+		ss = append(ss, &MigrationStatus{Version: mg.version, Name: mg.name, Applied: ok, AppliedAt: at})
+	}
+	return ss, nil
+}
+
+// Pending returns the versions that have not been applied yet.
+func (m *Migrator) Pending(ctx context.Context) ([]int64, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var pending []int64
+	for _, mg := range m.migrations {
+		if _, ok := applied[mg.version]; !ok {
+			pending = append(pending, mg.version)
+		}
+	}
+	return pending, nil
+}
+
+// Up applies every pending migration.
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.To(ctx, m.migrations[len(m.migrations)-1].version)
+}
+
+// Down rolls back the most recently applied migration.
+func (m *Migrator) Down(ctx context.Context) error {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return err
+	}
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		if _, ok := applied[m.migrations[i].version]; ok {
+			return m.rollback(ctx, m.migrations[i])
+		}
+	}
+	return nil
+}
+
+// To migrates the schema up or down so that exactly the migrations with a
+// version lower than or equal to version are applied. Version 0 rolls back
+// everything.
+func (m *Migrator) To(ctx context.Context, version int64) error {
+	if version != 0 && m.find(version) == nil {
+		return fmt.Errorf("data: unknown migration version %d", version)
+	}
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return err
+	}
+	for v := range applied {
+		if m.find(v) == nil {
+			return fmt.Errorf("data: database has migration %d applied that this binary does not know about", v)
+		}
+	}
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		mg := m.migrations[i]
+		if _, ok := applied[mg.version]; ok && mg.version > version {
+			if err := m.rollback(ctx, mg); err != nil {
+				return err
+			}
+		}
+	}
+	for _, mg := range m.migrations {
+		if _, ok := applied[mg.version]; !ok && mg.version <= version {
+			if err := m.apply(ctx, mg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) find(version int64) *migration {
+	for _, mg := range m.migrations {
+		if mg.version == version {
+			return mg
+		}
+	}
+	return nil
+}
+
+// apply runs the up script of mg and records it. MySQL commits DDL
+// implicitly, so the transaction only guarantees atomicity on SQLite.
+func (m *Migrator) apply(ctx context.Context, mg *migration) error {
+	m.log.Infof("applying migration %d_%s", mg.version, mg.name)
+	return m.inTx(ctx, func(tx *sql.Tx) error {
+		if err := execScript(ctx, tx, mg.up); err != nil {
+			return fmt.Errorf("data: migration %d up: %w", mg.version, err)
+		}
+		if f := migrationFuncs[mg.version]; f != nil {
+			if err := f(ctx, tx); err != nil {
+				return fmt.Errorf("data: migration %d up: %w", mg.version, err)
+			}
+		}
+		_, err := tx.ExecContext(ctx, "INSERT INTO "+migrationTable+" (version, name, applied_at) VALUES (?, ?, ?)",
+			mg.version, mg.name, now())
+		return err
+	})
+}
+
+func (m *Migrator) rollback(ctx context.Context, mg *migration) error {
+	m.log.Infof("rolling back migration %d_%s", mg.version, mg.name)
+	return m.inTx(ctx, func(tx *sql.Tx) error {
+		if err := execScript(ctx, tx, mg.down); err != nil {
+			return fmt.Errorf("data: migration %d down: %w", mg.version, err)
+		}
+		_, err := tx.ExecContext(ctx, "DELETE FROM "+migrationTable+" WHERE version = ?", mg.version)
+		return err
+	})
+}
+
+func (m *Migrator) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// execScript runs every ";"-terminated statement of script in order, as
+// the mysql driver refuses multi-statement queries by default. Lines
+// starting with "--" are comments.
+func execScript(ctx context.Context, db interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+}, script string) error {
+	var lines []string
+	for _, line := range strings.Split(script, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), "--") {
+			lines = append(lines, line)
+		}
+	}
+	for _, stmt := range strings.Split(strings.Join(lines, "\n"), ";\n") {
+		stmt = strings.TrimSuffix(strings.TrimSpace(stmt), ";")
+		if stmt == "" {
+			continue
+		}
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rekeyUsernames sets the username_key of every user to the HandleKey of
+// their username. The stale keys are first moved out of the way, so that a
+// user taking the old key of another does not trip the unique index, while
+// two users whose handles have the same key fail the migration.
+func rekeyUsernames(ctx context.Context, tx *sql.Tx) error {
+	rows, err := tx.QueryContext(ctx, "SELECT id, username, username_key FROM users")
+	if err != nil {
+		return err
+	}
+	keys := make(map[int64]string)
+	for rows.Next() {
+		var (
+			id              int64
+			username, stale string
+		)
+		if err := rows.Scan(&id, &username, &stale); err != nil {
+			rows.Close()
+			return err
+		}
+		if key := biz.HandleKey(username); key != stale {
+			keys[id] = key
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	// No handle contains "#".
+	for id := range keys {
+		if _, err := tx.ExecContext(ctx, "UPDATE users SET username_key = ? WHERE id = ?", fmt.Sprintf("#%d", id), id); err != nil {
+			return err
+		}
+	}
+	for id, key := range keys {
+		_, err := tx.ExecContext(ctx, "UPDATE users SET username_key = ? WHERE id = ?", key, id)
+		if isDuplicate(err) {
+			return fmt.Errorf("the handle of user %d has the key %q of another handle, rename either user first", id, key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"user/internal/biz"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func newTestMigrator(t *testing.T) (*Migrator, *sql.DB) {
+	t.Helper()
+	c := &conf.Data_Database{Driver: "sqlite", Source: filepath.Join(t.TempDir(), "user.db")}
+	db, dialect, err := openDB(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	m, err := newMigrator(db, dialect, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m, db
+}
+
+// schema returns the definitions of every table and index but the
+// migration table and the internal tables of sqlite, in a stable order.
+func schema(t *testing.T, db *sql.DB) string {
+	t.Helper()
+	rows, err := db.Query("SELECT sql FROM sqlite_master WHERE sql IS NOT NULL AND name <> ? AND name NOT LIKE 'sqlite\\_%' ESCAPE '\\' ORDER BY type, name", migrationTable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var stmts []string
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			t.Fatal(err)
+		}
+		stmts = append(stmts, s)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return strings.Join(stmts, ";\n")
+}
+
+func TestMigrateUpDownUp(t *testing.T) {
+	m, db := newTestMigrator(t)
+	ctx := context.Background()
+	if err := m.Up(ctx); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	if pending, err := m.Pending(ctx); err != nil || len(pending) != 0 {
+		t.Fatalf("Pending() after Up() = %v, %v, want none", pending, err)
+	}
+	want := schema(t, db)
+
+	// Roll back one migration at a time, so that every down script runs
+	// against the schema its up script left.
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		if err := m.Down(ctx); err != nil {
+			t.Fatalf("Down() of %d error = %v", m.migrations[i].version, err)
+		}
+		pending, err := m.Pending(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(pending) != len(m.migrations)-i || pending[0] != m.migrations[i].version {
+			t.Fatalf("Pending() after Down() of %d = %v", m.migrations[i].version, pending)
+		}
+	}
+	if got := schema(t, db); got != "" {
+		t.Errorf("schema after rolling back everything = %q, want empty", got)
+	}
+	if err := m.Down(ctx); err != nil {
+		t.Errorf("Down() with nothing applied = %v, want nil", err)
+	}
+
+	if err := m.Up(ctx); err != nil {
+		t.Fatalf("second Up() error = %v", err)
+	}
+	if got := schema(t, db); got != want {
+		t.Errorf("schema after up/down/up differs:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestMigrateTo(t *testing.T) {
+	m, _ := newTestMigrator(t)
+	ctx := context.Background()
+	mid := m.migrations[len(m.migrations)/2].version
+	if err := m.To(ctx, mid); err != nil {
+		t.Fatalf("To(%d) error = %v", mid, err)
+	}
+	ss, err := m.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range ss {
+		if s.Applied != (s.Version <= mid) {
+			t.Errorf("Status() of %d applied = %t after To(%d)", s.Version, s.Applied, mid)
+		}
+	}
+	if err := m.To(ctx, 0); err != nil {
+		t.Fatalf("To(0) error = %v", err)
+	}
+	if pending, err := m.Pending(ctx); err != nil || len(pending) != len(m.migrations) {
+		t.Errorf("Pending() after To(0) = %v, %v, want all", pending, err)
+	}
+	if err := m.To(ctx, 9999); err == nil {
+		t.Error("To() of an unknown version succeeded")
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	sqlite, err := loadMigrations("sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mysql, err := loadMigrations("mysql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mysql) != len(sqlite) {
+		t.Fatalf("mysql has %d migrations, sqlite %d", len(mysql), len(sqlite))
+	}
+	for i := range sqlite {
+		if mysql[i].version != sqlite[i].version || mysql[i].name != sqlite[i].name {
+			t.Errorf("migration %d is %d_%s on mysql and %d_%s on sqlite", i, mysql[i].version, mysql[i].name, sqlite[i].version, sqlite[i].name)
+		}
+	}
+}
+
+// insertStaleUsers inserts users keyed as migration 10 did, at the version
+// before the one recomputing the keys.
+func insertStaleUsers(t *testing.T, m *Migrator, db *sql.DB, usernames ...string) {
+	t.Helper()
+	if err := m.To(context.Background(), 17); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range usernames {
+		_, err := db.Exec("INSERT INTO users (username, username_key, email, created_at, updated_at) VALUES (?, LOWER(?), ?, ?, ?)",
+			name, name, name+"@example.com", now(), now())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestMigrateRekeysUsernames(t *testing.T) {
+	m, db := newTestMigrator(t)
+	insertStaleUsers(t, m, db, "Éclair", "Straße", "jane")
+	if err := m.Up(context.Background()); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	users := &userRepo{data: &Data{db: db, dialect: "sqlite", cache: &cache{}}, log: log.NewHelper(log.DefaultLogger)}
+	for _, handle := range []string{"ÉCLAIR", "STRASSE", "Jane"} {
+		if _, err := users.FindByHandle(context.Background(), biz.HandleKey(handle)); err != nil {
+			t.Errorf("FindByHandle(%q) after the migration = %v", handle, err)
+		}
+	}
+}
+
+func TestMigrateRekeyConflict(t *testing.T) {
+	m, db := newTestMigrator(t)
+	insertStaleUsers(t, m, db, "Straße", "strasse")
+	if err := m.Up(context.Background()); err == nil {
+		t.Fatal("Up() with two users of the same handle key succeeded")
+	}
+	if pending, err := m.Pending(context.Background()); err != nil || len(pending) == 0 || pending[0] != 18 {
+		t.Errorf("Pending() after the failed migration = %v, %v, want 18 first", pending, err)
+	}
+}
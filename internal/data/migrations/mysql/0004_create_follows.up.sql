@@ -0,0 +1,11 @@
+CREATE TABLE follows (
+  follower_id BIGINT NOT NULL,
+  followee_id BIGINT NOT NULL,
+  created_at DATETIME(6) NOT NULL,
+  PRIMARY KEY (follower_id, followee_id),
+  KEY idx_follows_follower_created (follower_id, created_at),
+  KEY idx_follows_followee_created (followee_id, created_at),
+  CONSTRAINT chk_follows_not_self CHECK (follower_id <> followee_id),
+  CONSTRAINT fk_follows_follower FOREIGN KEY (follower_id) REFERENCES users (id) ON DELETE CASCADE,
+  CONSTRAINT fk_follows_followee FOREIGN KEY (followee_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
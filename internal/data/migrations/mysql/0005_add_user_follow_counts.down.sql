@@ -0,0 +1,3 @@
+ALTER TABLE users
+  DROP COLUMN follower_count,
+  DROP COLUMN following_count;
@@ -0,0 +1,6 @@
+ALTER TABLE users
+  ADD COLUMN follower_count BIGINT NOT NULL DEFAULT 0,
+  ADD COLUMN following_count BIGINT NOT NULL DEFAULT 0;
+UPDATE users u SET
+  follower_count = (SELECT COUNT(*) FROM follows f WHERE f.followee_id = u.id),
+  following_count = (SELECT COUNT(*) FROM follows f WHERE f.follower_id = u.id);
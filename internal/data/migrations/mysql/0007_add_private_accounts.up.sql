@@ -0,0 +1,12 @@
+ALTER TABLE users ADD COLUMN private BOOLEAN NOT NULL DEFAULT FALSE;
+CREATE TABLE follow_requests (
+  requester_id BIGINT NOT NULL,
+  target_id BIGINT NOT NULL,
+  created_at DATETIME(6) NOT NULL,
+  expires_at DATETIME(6) NOT NULL,
+  PRIMARY KEY (requester_id, target_id),
+  KEY idx_follow_requests_target_created (target_id, created_at),
+  KEY idx_follow_requests_expires (expires_at),
+  CONSTRAINT fk_follow_requests_requester FOREIGN KEY (requester_id) REFERENCES users (id) ON DELETE CASCADE,
+  CONSTRAINT fk_follow_requests_target FOREIGN KEY (target_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
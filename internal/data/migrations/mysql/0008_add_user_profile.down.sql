@@ -0,0 +1,9 @@
+ALTER TABLE users
+  DROP COLUMN display_name,
+  DROP COLUMN bio,
+  DROP COLUMN avatar_url,
+  DROP COLUMN location,
+  DROP COLUMN website,
+  DROP COLUMN birth_date,
+  DROP COLUMN locale,
+  DROP COLUMN timezone;
@@ -0,0 +1,9 @@
+ALTER TABLE users
+  ADD COLUMN display_name VARCHAR(50) NOT NULL DEFAULT '',
+  ADD COLUMN bio VARCHAR(300) NOT NULL DEFAULT '',
+  ADD COLUMN avatar_url VARCHAR(2048) NOT NULL DEFAULT '',
+  ADD COLUMN location VARCHAR(100) NOT NULL DEFAULT '',
+  ADD COLUMN website VARCHAR(200) NOT NULL DEFAULT '',
+  ADD COLUMN birth_date DATE NULL,
+  ADD COLUMN locale VARCHAR(35) NOT NULL DEFAULT '',
+  ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT '';
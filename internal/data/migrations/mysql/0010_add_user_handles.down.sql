@@ -0,0 +1,3 @@
+DROP TABLE handle_changes;
+ALTER TABLE users DROP INDEX uk_users_username_key;
+ALTER TABLE users DROP COLUMN username_key;
@@ -0,0 +1,15 @@
+ALTER TABLE users ADD COLUMN username_key VARCHAR(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL DEFAULT '';
+UPDATE users SET username_key = LOWER(username);
+ALTER TABLE users ADD UNIQUE KEY uk_users_username_key (username_key);
+CREATE TABLE handle_changes (
+  id BIGINT NOT NULL AUTO_INCREMENT,
+  user_id BIGINT NOT NULL,
+  handle VARCHAR(64) NOT NULL,
+  handle_key VARCHAR(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+  created_at DATETIME(6) NOT NULL,
+  expires_at DATETIME(6) NOT NULL,
+  PRIMARY KEY (id),
+  KEY idx_handle_changes_key_expires (handle_key, expires_at),
+  KEY idx_handle_changes_user_created (user_id, created_at),
+  CONSTRAINT fk_handle_changes_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
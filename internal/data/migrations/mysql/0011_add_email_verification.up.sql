@@ -0,0 +1,11 @@
+ALTER TABLE users ADD COLUMN email_verified BOOLEAN NOT NULL DEFAULT FALSE;
+CREATE TABLE email_verifications (
+  user_id BIGINT NOT NULL,
+  token_hash CHAR(64) NOT NULL,
+  email VARCHAR(255) NOT NULL,
+  created_at DATETIME(6) NOT NULL,
+  expires_at DATETIME(6) NOT NULL,
+  PRIMARY KEY (user_id),
+  UNIQUE KEY uk_email_verifications_token_hash (token_hash),
+  CONSTRAINT fk_email_verifications_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
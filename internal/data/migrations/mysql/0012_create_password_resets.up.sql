@@ -0,0 +1,10 @@
+CREATE TABLE password_resets (
+  user_id BIGINT NOT NULL,
+  token_hash CHAR(64) NOT NULL,
+  email VARCHAR(255) NOT NULL,
+  created_at DATETIME(6) NOT NULL,
+  expires_at DATETIME(6) NOT NULL,
+  PRIMARY KEY (user_id),
+  UNIQUE KEY uk_password_resets_token_hash (token_hash),
+  CONSTRAINT fk_password_resets_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
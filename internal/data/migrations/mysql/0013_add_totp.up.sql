@@ -0,0 +1,26 @@
+CREATE TABLE totp_secrets (
+  user_id BIGINT NOT NULL,
+  secret VARBINARY(255) NOT NULL,
+  confirmed_at DATETIME(6) NULL,
+  last_used_step BIGINT NOT NULL DEFAULT 0,
+  created_at DATETIME(6) NOT NULL,
+  PRIMARY KEY (user_id),
+  CONSTRAINT fk_totp_secrets_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+CREATE TABLE recovery_codes (
+  user_id BIGINT NOT NULL,
+  code_hash CHAR(64) NOT NULL,
+  created_at DATETIME(6) NOT NULL,
+  PRIMARY KEY (user_id, code_hash),
+  CONSTRAINT fk_recovery_codes_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+CREATE TABLE login_challenges (
+  token_hash CHAR(64) NOT NULL,
+  user_id BIGINT NOT NULL,
+  attempts INT NOT NULL DEFAULT 0,
+  created_at DATETIME(6) NOT NULL,
+  expires_at DATETIME(6) NOT NULL,
+  PRIMARY KEY (token_hash),
+  KEY idx_login_challenges_expires_at (expires_at),
+  CONSTRAINT fk_login_challenges_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,6 @@
+DROP TABLE oauth_authorization_codes;
+DROP TABLE oauth_clients;
+ALTER TABLE sessions
+  DROP KEY idx_sessions_client_id,
+  DROP COLUMN scope,
+  DROP COLUMN client_id;
@@ -0,0 +1,33 @@
+ALTER TABLE sessions
+  ADD COLUMN client_id VARCHAR(64) NOT NULL DEFAULT '',
+  ADD COLUMN scope VARCHAR(1024) NOT NULL DEFAULT '',
+  ADD KEY idx_sessions_client_id (client_id);
+CREATE TABLE oauth_clients (
+  id BIGINT NOT NULL AUTO_INCREMENT,
+  client_id VARCHAR(64) NOT NULL,
+  secret_hash CHAR(64) NOT NULL DEFAULT '',
+  name VARCHAR(100) NOT NULL,
+  redirect_uris TEXT NOT NULL,
+  grant_types VARCHAR(255) NOT NULL,
+  scopes TEXT NOT NULL,
+  created_at DATETIME(6) NOT NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY uk_oauth_clients_client_id (client_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+CREATE TABLE oauth_authorization_codes (
+  code_hash CHAR(64) NOT NULL,
+  client_id VARCHAR(64) NOT NULL,
+  user_id BIGINT NOT NULL,
+  redirect_uri VARCHAR(2048) NOT NULL DEFAULT '',
+  scope VARCHAR(1024) NOT NULL DEFAULT '',
+  nonce VARCHAR(512) NOT NULL DEFAULT '',
+  code_challenge VARCHAR(64) NOT NULL DEFAULT '',
+  created_at DATETIME(6) NOT NULL,
+  expires_at DATETIME(6) NOT NULL,
+  used_at DATETIME(6) NULL,
+  session_id VARCHAR(64) NOT NULL DEFAULT '',
+  PRIMARY KEY (code_hash),
+  KEY idx_oauth_authorization_codes_expires_at (expires_at),
+  CONSTRAINT fk_oauth_authorization_codes_client FOREIGN KEY (client_id) REFERENCES oauth_clients (client_id) ON DELETE CASCADE,
+  CONSTRAINT fk_oauth_authorization_codes_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
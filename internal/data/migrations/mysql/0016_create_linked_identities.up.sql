@@ -0,0 +1,23 @@
+CREATE TABLE linked_identities (
+  id BIGINT NOT NULL AUTO_INCREMENT,
+  user_id BIGINT NOT NULL,
+  provider VARCHAR(64) NOT NULL,
+  subject VARCHAR(255) NOT NULL,
+  email VARCHAR(255) NOT NULL DEFAULT '',
+  created_at DATETIME(6) NOT NULL,
+  last_login_at DATETIME(6) NOT NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY uk_linked_identities_provider_subject (provider, subject),
+  UNIQUE KEY uk_linked_identities_user_provider (user_id, provider),
+  CONSTRAINT fk_linked_identities_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+CREATE TABLE federated_logins (
+  state_hash CHAR(64) NOT NULL,
+  provider VARCHAR(64) NOT NULL,
+  nonce VARCHAR(64) NOT NULL,
+  code_verifier VARCHAR(128) NOT NULL,
+  created_at DATETIME(6) NOT NULL,
+  expires_at DATETIME(6) NOT NULL,
+  PRIMARY KEY (state_hash),
+  KEY idx_federated_logins_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
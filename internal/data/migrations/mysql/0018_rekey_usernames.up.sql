@@ -0,0 +1,3 @@
+-- Migration 10 keyed the existing handles with LOWER, which is not
+-- biz.HandleKey for every handle. rekeyUsernames recomputes the keys after
+-- this script.
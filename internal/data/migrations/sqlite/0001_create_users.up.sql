@@ -0,0 +1,9 @@
+CREATE TABLE users (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  username TEXT NOT NULL,
+  email TEXT NOT NULL,
+  created_at DATETIME NOT NULL,
+  updated_at DATETIME NOT NULL,
+  CONSTRAINT uk_users_username UNIQUE (username),
+  CONSTRAINT uk_users_email UNIQUE (email)
+);
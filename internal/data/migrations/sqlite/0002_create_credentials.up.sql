@@ -0,0 +1,7 @@
+CREATE TABLE credentials (
+  user_id INTEGER NOT NULL PRIMARY KEY,
+  password_hash TEXT NOT NULL,
+  created_at DATETIME NOT NULL,
+  updated_at DATETIME NOT NULL,
+  CONSTRAINT fk_credentials_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
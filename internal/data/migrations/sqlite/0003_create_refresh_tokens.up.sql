@@ -0,0 +1,13 @@
+CREATE TABLE refresh_tokens (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  family_id TEXT NOT NULL,
+  token_hash TEXT NOT NULL,
+  expires_at DATETIME NOT NULL,
+  created_at DATETIME NOT NULL,
+  rotated_at DATETIME NULL,
+  revoked_at DATETIME NULL,
+  CONSTRAINT uk_refresh_tokens_token_hash UNIQUE (token_hash),
+  CONSTRAINT fk_refresh_tokens_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE INDEX idx_refresh_tokens_user_family ON refresh_tokens (user_id, family_id);
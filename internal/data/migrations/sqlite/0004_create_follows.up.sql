@@ -0,0 +1,11 @@
+CREATE TABLE follows (
+  follower_id INTEGER NOT NULL,
+  followee_id INTEGER NOT NULL,
+  created_at DATETIME NOT NULL,
+  PRIMARY KEY (follower_id, followee_id),
+  CONSTRAINT chk_follows_not_self CHECK (follower_id <> followee_id),
+  CONSTRAINT fk_follows_follower FOREIGN KEY (follower_id) REFERENCES users (id) ON DELETE CASCADE,
+  CONSTRAINT fk_follows_followee FOREIGN KEY (followee_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE INDEX idx_follows_follower_created ON follows (follower_id, created_at);
+CREATE INDEX idx_follows_followee_created ON follows (followee_id, created_at);
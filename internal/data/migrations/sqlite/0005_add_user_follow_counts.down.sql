@@ -0,0 +1,2 @@
+ALTER TABLE users DROP COLUMN follower_count;
+ALTER TABLE users DROP COLUMN following_count;
@@ -0,0 +1,5 @@
+ALTER TABLE users ADD COLUMN follower_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN following_count INTEGER NOT NULL DEFAULT 0;
+UPDATE users SET
+  follower_count = (SELECT COUNT(*) FROM follows f WHERE f.followee_id = users.id),
+  following_count = (SELECT COUNT(*) FROM follows f WHERE f.follower_id = users.id);
@@ -0,0 +1,20 @@
+CREATE TABLE blocks (
+  blocker_id INTEGER NOT NULL,
+  blocked_id INTEGER NOT NULL,
+  created_at DATETIME NOT NULL,
+  PRIMARY KEY (blocker_id, blocked_id),
+  CONSTRAINT chk_blocks_not_self CHECK (blocker_id <> blocked_id),
+  CONSTRAINT fk_blocks_blocker FOREIGN KEY (blocker_id) REFERENCES users (id) ON DELETE CASCADE,
+  CONSTRAINT fk_blocks_blocked FOREIGN KEY (blocked_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE INDEX idx_blocks_blocked ON blocks (blocked_id);
+CREATE TABLE mutes (
+  muter_id INTEGER NOT NULL,
+  muted_id INTEGER NOT NULL,
+  created_at DATETIME NOT NULL,
+  PRIMARY KEY (muter_id, muted_id),
+  CONSTRAINT chk_mutes_not_self CHECK (muter_id <> muted_id),
+  CONSTRAINT fk_mutes_muter FOREIGN KEY (muter_id) REFERENCES users (id) ON DELETE CASCADE,
+  CONSTRAINT fk_mutes_muted FOREIGN KEY (muted_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE INDEX idx_mutes_muted ON mutes (muted_id);
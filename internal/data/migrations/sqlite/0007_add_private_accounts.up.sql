@@ -0,0 +1,12 @@
+ALTER TABLE users ADD COLUMN private BOOLEAN NOT NULL DEFAULT 0;
+CREATE TABLE follow_requests (
+  requester_id INTEGER NOT NULL,
+  target_id INTEGER NOT NULL,
+  created_at DATETIME NOT NULL,
+  expires_at DATETIME NOT NULL,
+  PRIMARY KEY (requester_id, target_id),
+  CONSTRAINT fk_follow_requests_requester FOREIGN KEY (requester_id) REFERENCES users (id) ON DELETE CASCADE,
+  CONSTRAINT fk_follow_requests_target FOREIGN KEY (target_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE INDEX idx_follow_requests_target_created ON follow_requests (target_id, created_at);
+CREATE INDEX idx_follow_requests_expires ON follow_requests (expires_at);
@@ -0,0 +1,8 @@
+ALTER TABLE users DROP COLUMN display_name;
+ALTER TABLE users DROP COLUMN bio;
+ALTER TABLE users DROP COLUMN avatar_url;
+ALTER TABLE users DROP COLUMN location;
+ALTER TABLE users DROP COLUMN website;
+ALTER TABLE users DROP COLUMN birth_date;
+ALTER TABLE users DROP COLUMN locale;
+ALTER TABLE users DROP COLUMN timezone;
@@ -0,0 +1,14 @@
+ALTER TABLE users ADD COLUMN username_key TEXT NOT NULL DEFAULT '';
+UPDATE users SET username_key = LOWER(username);
+CREATE UNIQUE INDEX uk_users_username_key ON users (username_key);
+CREATE TABLE handle_changes (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  handle TEXT NOT NULL,
+  handle_key TEXT NOT NULL,
+  created_at DATETIME NOT NULL,
+  expires_at DATETIME NOT NULL,
+  CONSTRAINT fk_handle_changes_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE INDEX idx_handle_changes_key_expires ON handle_changes (handle_key, expires_at);
+CREATE INDEX idx_handle_changes_user_created ON handle_changes (user_id, created_at);
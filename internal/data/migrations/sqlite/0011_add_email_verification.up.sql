@@ -0,0 +1,10 @@
+ALTER TABLE users ADD COLUMN email_verified BOOLEAN NOT NULL DEFAULT 0;
+CREATE TABLE email_verifications (
+  user_id INTEGER NOT NULL PRIMARY KEY,
+  token_hash TEXT NOT NULL,
+  email TEXT NOT NULL,
+  created_at DATETIME NOT NULL,
+  expires_at DATETIME NOT NULL,
+  CONSTRAINT uk_email_verifications_token_hash UNIQUE (token_hash),
+  CONSTRAINT fk_email_verifications_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
@@ -0,0 +1,9 @@
+CREATE TABLE password_resets (
+  user_id INTEGER NOT NULL PRIMARY KEY,
+  token_hash TEXT NOT NULL,
+  email TEXT NOT NULL,
+  created_at DATETIME NOT NULL,
+  expires_at DATETIME NOT NULL,
+  CONSTRAINT uk_password_resets_token_hash UNIQUE (token_hash),
+  CONSTRAINT fk_password_resets_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
@@ -0,0 +1,24 @@
+CREATE TABLE totp_secrets (
+  user_id INTEGER NOT NULL PRIMARY KEY,
+  secret BLOB NOT NULL,
+  confirmed_at DATETIME NULL,
+  last_used_step INTEGER NOT NULL DEFAULT 0,
+  created_at DATETIME NOT NULL,
+  CONSTRAINT fk_totp_secrets_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE TABLE recovery_codes (
+  user_id INTEGER NOT NULL,
+  code_hash TEXT NOT NULL,
+  created_at DATETIME NOT NULL,
+  PRIMARY KEY (user_id, code_hash),
+  CONSTRAINT fk_recovery_codes_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE TABLE login_challenges (
+  token_hash TEXT NOT NULL PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  attempts INTEGER NOT NULL DEFAULT 0,
+  created_at DATETIME NOT NULL,
+  expires_at DATETIME NOT NULL,
+  CONSTRAINT fk_login_challenges_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE INDEX idx_login_challenges_expires_at ON login_challenges (expires_at);
@@ -0,0 +1,5 @@
+DROP TABLE oauth_authorization_codes;
+DROP TABLE oauth_clients;
+DROP INDEX idx_sessions_client_id;
+ALTER TABLE sessions DROP COLUMN scope;
+ALTER TABLE sessions DROP COLUMN client_id;
@@ -0,0 +1,30 @@
+ALTER TABLE sessions ADD COLUMN client_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE sessions ADD COLUMN scope TEXT NOT NULL DEFAULT '';
+CREATE INDEX idx_sessions_client_id ON sessions (client_id);
+CREATE TABLE oauth_clients (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  client_id TEXT NOT NULL,
+  secret_hash TEXT NOT NULL DEFAULT '',
+  name TEXT NOT NULL,
+  redirect_uris TEXT NOT NULL DEFAULT '',
+  grant_types TEXT NOT NULL,
+  scopes TEXT NOT NULL,
+  created_at DATETIME NOT NULL,
+  CONSTRAINT uk_oauth_clients_client_id UNIQUE (client_id)
+);
+CREATE TABLE oauth_authorization_codes (
+  code_hash TEXT NOT NULL PRIMARY KEY,
+  client_id TEXT NOT NULL,
+  user_id INTEGER NOT NULL,
+  redirect_uri TEXT NOT NULL DEFAULT '',
+  scope TEXT NOT NULL DEFAULT '',
+  nonce TEXT NOT NULL DEFAULT '',
+  code_challenge TEXT NOT NULL DEFAULT '',
+  created_at DATETIME NOT NULL,
+  expires_at DATETIME NOT NULL,
+  used_at DATETIME NULL,
+  session_id TEXT NOT NULL DEFAULT '',
+  CONSTRAINT fk_oauth_authorization_codes_client FOREIGN KEY (client_id) REFERENCES oauth_clients (client_id) ON DELETE CASCADE,
+  CONSTRAINT fk_oauth_authorization_codes_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE INDEX idx_oauth_authorization_codes_expires_at ON oauth_authorization_codes (expires_at);
@@ -0,0 +1,2 @@
+DROP TABLE federated_logins;
+DROP TABLE linked_identities;
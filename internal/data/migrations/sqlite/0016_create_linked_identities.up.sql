@@ -0,0 +1,21 @@
+CREATE TABLE linked_identities (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  provider TEXT NOT NULL,
+  subject TEXT NOT NULL,
+  email TEXT NOT NULL DEFAULT '',
+  created_at DATETIME NOT NULL,
+  last_login_at DATETIME NOT NULL,
+  CONSTRAINT uk_linked_identities_provider_subject UNIQUE (provider, subject),
+  CONSTRAINT uk_linked_identities_user_provider UNIQUE (user_id, provider),
+  CONSTRAINT fk_linked_identities_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE TABLE federated_logins (
+  state_hash TEXT NOT NULL PRIMARY KEY,
+  provider TEXT NOT NULL,
+  nonce TEXT NOT NULL,
+  code_verifier TEXT NOT NULL,
+  created_at DATETIME NOT NULL,
+  expires_at DATETIME NOT NULL
+);
+CREATE INDEX idx_federated_logins_expires_at ON federated_logins (expires_at);
@@ -0,0 +1,14 @@
+CREATE TABLE api_keys (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  prefix TEXT NOT NULL,
+  key_hash TEXT NOT NULL,
+  scopes TEXT NOT NULL,
+  created_at DATETIME NOT NULL,
+  expires_at DATETIME NULL,
+  last_used_at DATETIME NULL,
+  CONSTRAINT uk_api_keys_prefix UNIQUE (prefix),
+  CONSTRAINT fk_api_keys_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE INDEX idx_api_keys_user_id ON api_keys (user_id);
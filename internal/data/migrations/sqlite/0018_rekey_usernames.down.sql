@@ -0,0 +1 @@
+-- The recomputed keys are the ones the service expects, so they are kept.
@@ -0,0 +1,59 @@
+package data
+
+import (
+	"context"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type muteRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewMuteRepo .
+func NewMuteRepo(data *Data, logger log.Logger) biz.MuteRepo {
+	if data.mem != nil {
+		return &memoryMuteRepo{data: data}
+	}
+	return &muteRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *muteRepo) Save(ctx context.Context, m *biz.Mute) (*biz.Mute, error) {
+	t := now()
+	_, err := r.data.conn(ctx).ExecContext(ctx,
+		"INSERT INTO mutes (muter_id, muted_id, created_at) VALUES (?, ?, ?)",
+		m.MuterID, m.MutedID, t)
+	if isDuplicate(err) {
+		return nil, biz.ErrAlreadyMuted
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &biz.Mute{MuterID: m.MuterID, MutedID: m.MutedID, CreatedAt: t}, nil
+}
+
+func (r *muteRepo) Delete(ctx context.Context, muterID, mutedID int64) error {
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"DELETE FROM mutes WHERE muter_id = ? AND muted_id = ?", muterID, mutedID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return biz.ErrNotMuted
+	}
+	return nil
+}
+
+func (r *muteRepo) Exists(ctx context.Context, muterID, mutedID int64) (bool, error) {
+	var n int
+	err := r.data.conn(ctx).QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM mutes WHERE muter_id = ? AND muted_id = ?", muterID, mutedID).Scan(&n)
+	return n > 0, err
+}
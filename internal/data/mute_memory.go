@@ -0,0 +1,49 @@
+package data
+
+import (
+	"context"
+
+	"user/internal/biz"
+)
+
+// memoryMuteRepo is the biz.MuteRepo of the memory driver.
+type memoryMuteRepo struct {
+	data *Data
+}
+
+func (r *memoryMuteRepo) Save(ctx context.Context, m *biz.Mute) (*biz.Mute, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	if _, ok := s.users[m.MuterID]; !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	if _, ok := s.users[m.MutedID]; !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	k := edgeKey{m.MuterID, m.MutedID}
+	if _, ok := s.mutes[k]; ok {
+		return nil, biz.ErrAlreadyMuted
+	}
+	nm := &biz.Mute{MuterID: m.MuterID, MutedID: m.MutedID, CreatedAt: now()}
+	s.mutes[k] = nm
+	c := *nm
+	return &c, nil
+}
+
+func (r *memoryMuteRepo) Delete(ctx context.Context, muterID, mutedID int64) error {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	k := edgeKey{muterID, mutedID}
+	if _, ok := s.mutes[k]; !ok {
+		return biz.ErrNotMuted
+	}
+	delete(s.mutes, k)
+	return nil
+}
+
+func (r *memoryMuteRepo) Exists(ctx context.Context, muterID, mutedID int64) (bool, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	_, ok := s.mutes[edgeKey{muterID, mutedID}]
+	return ok, nil
+}
@@ -0,0 +1,106 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const oauthClientColumns = "id, client_id, secret_hash, name, redirect_uris, grant_types, scopes, created_at"
+
+type oauthClientRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewOAuthClientRepo .
+func NewOAuthClientRepo(data *Data, logger log.Logger) biz.OAuthClientRepo {
+	if data.mem != nil {
+		return &memoryOAuthClientRepo{data: data}
+	}
+	return &oauthClientRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *oauthClientRepo) Save(ctx context.Context, c *biz.OAuthClient) (*biz.OAuthClient, error) {
+	nc := *c
+	nc.CreatedAt = now()
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"INSERT INTO oauth_clients (client_id, secret_hash, name, redirect_uris, grant_types, scopes, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		nc.ClientID, nc.SecretHash, nc.Name, strings.Join(nc.RedirectURIs, " "), strings.Join(nc.GrantTypes, " "),
+		strings.Join(nc.Scopes, " "), nc.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if nc.ID, err = res.LastInsertId(); err != nil {
+		return nil, err
+	}
+	return &nc, nil
+}
+
+func (r *oauthClientRepo) FindByClientID(ctx context.Context, clientID string) (*biz.OAuthClient, error) {
+	c, err := scanOAuthClient(r.data.conn(ctx).QueryRowContext(ctx,
+		"SELECT "+oauthClientColumns+" FROM oauth_clients WHERE client_id = ?", clientID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrOAuthClientNotFound
+	}
+	return c, err
+}
+
+func (r *oauthClientRepo) List(ctx context.Context, page *biz.Page) ([]*biz.OAuthClient, error) {
+	var after int64
+	if page.After != nil {
+		after = page.After.ID
+	}
+	rows, err := r.data.conn(ctx).QueryContext(ctx,
+		"SELECT "+oauthClientColumns+" FROM oauth_clients WHERE id > ? ORDER BY id LIMIT ?", after, page.Size+1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var cs []*biz.OAuthClient
+	for rows.Next() {
+		c, err := scanOAuthClient(rows)
+		if err != nil {
+			return nil, err
+		}
+		cs = append(cs, c)
+	}
+	return cs, rows.Err()
+}
+
+func (r *oauthClientRepo) Delete(ctx context.Context, clientID string) error {
+	res, err := r.data.conn(ctx).ExecContext(ctx, "DELETE FROM oauth_clients WHERE client_id = ?", clientID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return biz.ErrOAuthClientNotFound
+	}
+	return nil
+}
+
+// scanOAuthClient scans a client, whose lists are stored space separated:
+// none of their items may hold a space.
+func scanOAuthClient(row scanner) (*biz.OAuthClient, error) {
+	var (
+		c                                biz.OAuthClient
+		redirectURIs, grantTypes, scopes string
+	)
+	err := row.Scan(&c.ID, &c.ClientID, &c.SecretHash, &c.Name, &redirectURIs, &grantTypes, &scopes, &c.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	c.RedirectURIs = strings.Fields(redirectURIs)
+	c.GrantTypes = strings.Fields(grantTypes)
+	c.Scopes = strings.Fields(scopes)
+	return &c, nil
+}
@@ -0,0 +1,79 @@
+package data
+
+import (
+	"context"
+	"sort"
+
+	"user/internal/biz"
+)
+
+// memoryOAuthClientRepo is the biz.OAuthClientRepo of the memory driver.
+type memoryOAuthClientRepo struct {
+	data *Data
+}
+
+func (r *memoryOAuthClientRepo) Save(ctx context.Context, c *biz.OAuthClient) (*biz.OAuthClient, error) {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	nc := copyOAuthClient(c)
+	nc.ID = m.nextID("oauth_clients")
+	nc.CreatedAt = now()
+	m.oauthClients[nc.ClientID] = nc
+	return copyOAuthClient(nc), nil
+}
+
+func (r *memoryOAuthClientRepo) FindByClientID(ctx context.Context, clientID string) (*biz.OAuthClient, error) {
+	m := r.data.mem
+	defer m.rlock(ctx)()
+	c, ok := m.oauthClients[clientID]
+	if !ok {
+		return nil, biz.ErrOAuthClientNotFound
+	}
+	return copyOAuthClient(c), nil
+}
+
+func (r *memoryOAuthClientRepo) List(ctx context.Context, page *biz.Page) ([]*biz.OAuthClient, error) {
+	m := r.data.mem
+	defer m.rlock(ctx)()
+	var after int64
+	if page.After != nil {
+		after = page.After.ID
+	}
+	var cs []*biz.OAuthClient
+	for _, c := range m.oauthClients {
+		if c.ID > after {
+			cs = append(cs, c)
+		}
+	}
+	sort.Slice(cs, func(i, j int) bool { return cs[i].ID < cs[j].ID })
+	if len(cs) > page.Size+1 {
+		cs = cs[:page.Size+1]
+	}
+	for i, c := range cs {
+		cs[i] = copyOAuthClient(c)
+	}
+	return cs, nil
+}
+
+func (r *memoryOAuthClientRepo) Delete(ctx context.Context, clientID string) error {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	if _, ok := m.oauthClients[clientID]; !ok {
+		return biz.ErrOAuthClientNotFound
+	}
+	delete(m.oauthClients, clientID)
+	for k, c := range m.authorizationCodes {
+		if c.ClientID == clientID {
+			delete(m.authorizationCodes, k)
+		}
+	}
+	return nil
+}
+
+func copyOAuthClient(c *biz.OAuthClient) *biz.OAuthClient {
+	nc := *c
+	nc.RedirectURIs = append([]string(nil), c.RedirectURIs...)
+	nc.GrantTypes = append([]string(nil), c.GrantTypes...)
+	nc.Scopes = append([]string(nil), c.Scopes...)
+	return &nc
+}
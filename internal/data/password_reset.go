@@ -0,0 +1,78 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const passwordResetColumns = "user_id, token_hash, email, created_at, expires_at"
+
+type passwordResetRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPasswordResetRepo .
+func NewPasswordResetRepo(data *Data, logger log.Logger) biz.PasswordResetRepo {
+	if data.mem != nil {
+		return &memoryPasswordResetRepo{data: data}
+	}
+	return &passwordResetRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *passwordResetRepo) Save(ctx context.Context, pr *biz.PasswordReset, since time.Time) (bool, error) {
+	saved := true
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		if _, err := r.data.conn(ctx).ExecContext(ctx,
+			"DELETE FROM password_resets WHERE user_id = ? AND created_at <= ?", pr.UserID, since.UTC()); err != nil {
+			return err
+		}
+		// The primary key on user_id makes the insert fail while a reset
+		// created after since remains, including one inserted concurrently.
+		_, err := r.data.conn(ctx).ExecContext(ctx,
+			"INSERT INTO password_resets ("+passwordResetColumns+") VALUES (?, ?, ?, ?, ?)",
+			pr.UserID, pr.TokenHash, pr.Email, now(), pr.ExpiresAt.UTC().Truncate(time.Microsecond))
+		if isDuplicate(err) {
+			saved, err = false, nil
+		}
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+	return saved, nil
+}
+
+func (r *passwordResetRepo) Find(ctx context.Context, userID int64) (*biz.PasswordReset, error) {
+	return r.find(ctx, "SELECT "+passwordResetColumns+" FROM password_resets WHERE user_id = ?", userID)
+}
+
+func (r *passwordResetRepo) FindByTokenHash(ctx context.Context, hash string) (*biz.PasswordReset, error) {
+	return r.find(ctx, "SELECT "+passwordResetColumns+" FROM password_resets WHERE token_hash = ?", hash)
+}
+
+func (r *passwordResetRepo) find(ctx context.Context, query string, arg interface{}) (*biz.PasswordReset, error) {
+	var pr biz.PasswordReset
+	err := r.data.conn(ctx).QueryRowContext(ctx, query, arg).
+		Scan(&pr.UserID, &pr.TokenHash, &pr.Email, &pr.CreatedAt, &pr.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrPasswordResetTokenInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+func (r *passwordResetRepo) Delete(ctx context.Context, userID int64) error {
+	_, err := r.data.conn(ctx).ExecContext(ctx, "DELETE FROM password_resets WHERE user_id = ?", userID)
+	return err
+}
@@ -0,0 +1,59 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"user/internal/biz"
+)
+
+// memoryPasswordResetRepo is the biz.PasswordResetRepo of the memory driver.
+type memoryPasswordResetRepo struct {
+	data *Data
+}
+
+func (r *memoryPasswordResetRepo) Save(ctx context.Context, pr *biz.PasswordReset, since time.Time) (bool, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	if _, ok := s.users[pr.UserID]; !ok {
+		return false, biz.ErrUserNotFound
+	}
+	if old, ok := s.passwordResets[pr.UserID]; ok && old.CreatedAt.After(since) {
+		return false, nil
+	}
+	npr := *pr
+	npr.CreatedAt = now()
+	npr.ExpiresAt = pr.ExpiresAt.UTC().Truncate(time.Microsecond)
+	s.passwordResets[npr.UserID] = &npr
+	return true, nil
+}
+
+func (r *memoryPasswordResetRepo) Find(ctx context.Context, userID int64) (*biz.PasswordReset, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	pr, ok := s.passwordResets[userID]
+	if !ok {
+		return nil, biz.ErrPasswordResetTokenInvalid
+	}
+	c := *pr
+	return &c, nil
+}
+
+func (r *memoryPasswordResetRepo) FindByTokenHash(ctx context.Context, hash string) (*biz.PasswordReset, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	for _, pr := range s.passwordResets {
+		if pr.TokenHash == hash {
+			c := *pr
+			return &c, nil
+		}
+	}
+	return nil, biz.ErrPasswordResetTokenInvalid
+}
+
+func (r *memoryPasswordResetRepo) Delete(ctx context.Context, userID int64) error {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	delete(s.passwordResets, userID)
+	return nil
+}
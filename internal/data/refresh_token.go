@@ -0,0 +1,104 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const refreshTokenColumns = "id, user_id, family_id, token_hash, expires_at, created_at, rotated_at, revoked_at"
+
+type refreshTokenRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewRefreshTokenRepo .
+func NewRefreshTokenRepo(data *Data, logger log.Logger) biz.RefreshTokenRepo {
+	if data.mem != nil {
+		return &memoryRefreshTokenRepo{data: data}
+	}
+	return &refreshTokenRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *refreshTokenRepo) Save(ctx context.Context, t *biz.RefreshToken) (*biz.RefreshToken, error) {
+	nt := *t
+	nt.CreatedAt = now()
+	nt.ExpiresAt = t.ExpiresAt.UTC().Truncate(time.Microsecond)
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at, created_at) VALUES (?, ?, ?, ?, ?)",
+		nt.UserID, nt.FamilyID, nt.TokenHash, nt.ExpiresAt, nt.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if nt.ID, err = res.LastInsertId(); err != nil {
+		return nil, err
+	}
+	return &nt, nil
+}
+
+func (r *refreshTokenRepo) FindByHash(ctx context.Context, hash string) (*biz.RefreshToken, error) {
+	var (
+		t                biz.RefreshToken
+		rotated, revoked sql.NullTime
+	)
+	err := r.data.conn(ctx).QueryRowContext(ctx,
+		"SELECT "+refreshTokenColumns+" FROM refresh_tokens WHERE token_hash = ?", hash).
+		Scan(&t.ID, &t.UserID, &t.FamilyID, &t.TokenHash, &t.ExpiresAt, &t.CreatedAt, &rotated, &revoked)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrRefreshTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	t.RotatedAt = nullTime(rotated)
+	t.RevokedAt = nullTime(revoked)
+	return &t, nil
+}
+
+func (r *refreshTokenRepo) MarkRotated(ctx context.Context, id int64, at time.Time) (bool, error) {
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"UPDATE refresh_tokens SET rotated_at = ? WHERE id = ? AND rotated_at IS NULL", at.UTC(), id)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
+
+func (r *refreshTokenRepo) RevokeFamily(ctx context.Context, userID int64, familyID string, at time.Time) (int64, error) {
+	var n int64
+	err := r.data.conn(ctx).QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM refresh_tokens WHERE user_id = ? AND family_id = ?", userID, familyID).Scan(&n)
+	if err != nil || n == 0 {
+		return 0, err
+	}
+	_, err = r.data.conn(ctx).ExecContext(ctx,
+		"UPDATE refresh_tokens SET revoked_at = ? WHERE user_id = ? AND family_id = ? AND revoked_at IS NULL",
+		at.UTC(), userID, familyID)
+	return n, err
+}
+
+func (r *refreshTokenRepo) RevokeAll(ctx context.Context, userID int64, at time.Time) (int64, error) {
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"UPDATE refresh_tokens SET revoked_at = ? WHERE user_id = ? AND revoked_at IS NULL", at.UTC(), userID)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// nullTime converts a nullable column to an optional time.
+func nullTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
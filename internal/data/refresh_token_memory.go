@@ -0,0 +1,84 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"user/internal/biz"
+)
+
+// memoryRefreshTokenRepo is the biz.RefreshTokenRepo of the memory driver.
+type memoryRefreshTokenRepo struct {
+	data *Data
+}
+
+func (r *memoryRefreshTokenRepo) Save(ctx context.Context, t *biz.RefreshToken) (*biz.RefreshToken, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	if _, ok := s.users[t.UserID]; !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	nt := *t
+	nt.ID, nt.CreatedAt = s.nextID("refresh_tokens"), now()
+	s.refreshTokens[nt.ID] = &nt
+	c := nt
+	return &c, nil
+}
+
+func (r *memoryRefreshTokenRepo) FindByHash(ctx context.Context, hash string) (*biz.RefreshToken, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	for _, t := range s.refreshTokens {
+		if t.TokenHash == hash {
+			c := *t
+			return &c, nil
+		}
+	}
+	return nil, biz.ErrRefreshTokenNotFound
+}
+
+func (r *memoryRefreshTokenRepo) MarkRotated(ctx context.Context, id int64, at time.Time) (bool, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	t, ok := s.refreshTokens[id]
+	if !ok || t.RotatedAt != nil {
+		return false, nil
+	}
+	nt := *t
+	nt.RotatedAt = &at
+	s.refreshTokens[id] = &nt
+	return true, nil
+}
+
+func (r *memoryRefreshTokenRepo) RevokeFamily(ctx context.Context, userID int64, familyID string, at time.Time) (int64, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	var n int64
+	for id, t := range s.refreshTokens {
+		if t.UserID != userID || t.FamilyID != familyID {
+			continue
+		}
+		n++
+		if t.RevokedAt == nil {
+			nt := *t
+			nt.RevokedAt = &at
+			s.refreshTokens[id] = &nt
+		}
+	}
+	return n, nil
+}
+
+func (r *memoryRefreshTokenRepo) RevokeAll(ctx context.Context, userID int64, at time.Time) (int64, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	var n int64
+	for id, t := range s.refreshTokens {
+		if t.UserID == userID && t.RevokedAt == nil {
+			nt := *t
+			nt.RevokedAt = &at
+			s.refreshTokens[id] = &nt
+			n++
+		}
+	}
+	return n, nil
+}
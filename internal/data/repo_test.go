@@ -0,0 +1,468 @@
+package data
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"user/internal/biz"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-sql-driver/mysql"
+)
+
+// forEachDriver runs test against a new empty database of every driver
+// whose repos must behave the same.
+func forEachDriver(t *testing.T, test func(t *testing.T, d *Data)) {
+	t.Run("memory", func(t *testing.T) {
+		test(t, newTestData(t, &conf.Data_Database{Driver: memoryDriver}))
+	})
+	t.Run("sqlite", func(t *testing.T) {
+		c := &conf.Data_Database{Driver: "sqlite", Source: filepath.Join(t.TempDir(), "user.db")}
+		m, cleanup, err := NewMigrator(&conf.Data{Database: c}, log.DefaultLogger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = m.Up(context.Background())
+		cleanup()
+		if err != nil {
+			t.Fatal(err)
+		}
+		test(t, newTestData(t, c))
+	})
+}
+
+func newTestData(t *testing.T, c *conf.Data_Database) *Data {
+	t.Helper()
+	d, cleanup, err := NewData(&conf.Data{Database: c}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	return d
+}
+
+// saveUser saves a user named username with an email derived from it.
+func saveUser(t *testing.T, users biz.UserRepo, username string) *biz.User {
+	t.Helper()
+	u, err := users.Save(context.Background(), &biz.User{Username: username, Email: username + "@example.com"})
+	if err != nil {
+		t.Fatalf("Save(%s) error = %v", username, err)
+	}
+	return u
+}
+
+func userIDs(us []*biz.User) []int64 {
+	ids := make([]int64, 0, len(us))
+	for _, u := range us {
+		ids = append(ids, u.ID)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func equalIDs(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestUserRepoSaveFind(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, d *Data) {
+		users := NewUserRepo(d, log.DefaultLogger)
+		ctx := context.Background()
+		jane := saveUser(t, users, "Jane")
+		if jane.ID == 0 || jane.Version != 1 || jane.EmailVerified || jane.CreatedAt.IsZero() {
+			t.Errorf("Save() = %+v, want a new unverified user at version 1", jane)
+		}
+		if _, err := users.Save(ctx, &biz.User{Username: "other", Email: jane.Email}); !errors.Is(err, biz.ErrEmailTaken) {
+			t.Errorf("Save() with a taken email = %v, want ErrEmailTaken", err)
+		}
+		if _, err := users.Save(ctx, &biz.User{Username: "JANE", Email: "other@example.com"}); !errors.Is(err, biz.ErrUsernameTaken) {
+			t.Errorf("Save() with a taken handle = %v, want ErrUsernameTaken", err)
+		}
+
+		for name, find := range map[string]func() (*biz.User, error){
+			"FindByID":     func() (*biz.User, error) { return users.FindByID(ctx, jane.ID) },
+			"FindByEmail":  func() (*biz.User, error) { return users.FindByEmail(ctx, jane.Email) },
+			"FindByHandle": func() (*biz.User, error) { return users.FindByHandle(ctx, biz.HandleKey("jAnE")) },
+		} {
+			if u, err := find(); err != nil || u.ID != jane.ID || u.Username != "Jane" {
+				t.Errorf("%s() = %+v, %v, want %d", name, u, err, jane.ID)
+			}
+		}
+		if _, err := users.FindByID(ctx, jane.ID+100); !errors.Is(err, biz.ErrUserNotFound) {
+			t.Errorf("FindByID() of a missing user = %v, want ErrUserNotFound", err)
+		}
+		if _, err := users.FindByEmail(ctx, "missing@example.com"); !errors.Is(err, biz.ErrUserNotFound) {
+			t.Errorf("FindByEmail() of a missing user = %v, want ErrUserNotFound", err)
+		}
+
+		joe := saveUser(t, users, "joe")
+		want := []int64{jane.ID, joe.ID}
+		if us, err := users.FindByIDs(ctx, []int64{joe.ID, jane.ID + 100, jane.ID}); err != nil || !equalIDs(userIDs(us), want) {
+			t.Errorf("FindByIDs() = %v, %v, want %v", userIDs(us), err, want)
+		}
+		if us, err := users.FindByHandles(ctx, []string{biz.HandleKey("JOE"), "missing", biz.HandleKey("jane")}); err != nil || !equalIDs(userIDs(us), want) {
+			t.Errorf("FindByHandles() = %v, %v, want %v", userIDs(us), err, want)
+		}
+	})
+}
+
+func TestUserRepoUpdate(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, d *Data) {
+		users := NewUserRepo(d, log.DefaultLogger)
+		ctx := context.Background()
+		jane := saveUser(t, users, "jane")
+		joe := saveUser(t, users, "joe")
+
+		u, err := users.Update(ctx, &biz.User{ID: jane.ID, Version: 1, Username: "ignored", Profile: biz.Profile{Bio: "hi"}}, []string{biz.PathProfileBio})
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		if u.Version != 2 || u.Profile.Bio != "hi" || u.Username != "jane" {
+			t.Errorf("Update() = %+v, want only the bio changed at version 2", u)
+		}
+		if _, err := users.Update(ctx, &biz.User{ID: jane.ID, Version: 1}, []string{biz.PathProfileBio}); !errors.Is(err, biz.ErrVersionConflict) {
+			t.Errorf("Update() at a stale version = %v, want ErrVersionConflict", err)
+		}
+		if _, err := users.Update(ctx, &biz.User{ID: jane.ID, Version: 2, Email: joe.Email}, []string{biz.PathEmail}); !errors.Is(err, biz.ErrEmailTaken) {
+			t.Errorf("Update() to a taken email = %v, want ErrEmailTaken", err)
+		}
+		if _, err := users.Update(ctx, &biz.User{ID: jane.ID + 100, Version: 1}, []string{biz.PathProfileBio}); !errors.Is(err, biz.ErrUserNotFound) {
+			t.Errorf("Update() of a missing user = %v, want ErrUserNotFound", err)
+		}
+
+		if _, err := users.VerifyEmail(ctx, jane.ID, "old@example.com"); !errors.Is(err, biz.ErrUserNotFound) {
+			t.Errorf("VerifyEmail() of another email = %v, want ErrUserNotFound", err)
+		}
+		if u, err = users.VerifyEmail(ctx, jane.ID, jane.Email); err != nil || !u.EmailVerified || u.Version != 3 {
+			t.Errorf("VerifyEmail() = %+v, %v, want verified at version 3", u, err)
+		}
+		u, err = users.Update(ctx, &biz.User{ID: jane.ID, Version: 3, Email: "jane@example.org"}, []string{biz.PathEmail})
+		if err != nil || u.Email != "jane@example.org" || u.EmailVerified {
+			t.Errorf("Update() of the email = %+v, %v, want an unverified new email", u, err)
+		}
+	})
+}
+
+func TestUserRepoDelete(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, d *Data) {
+		users := NewUserRepo(d, log.DefaultLogger)
+		graph := NewGraphRepo(d, log.DefaultLogger)
+		ctx := context.Background()
+		jane := saveUser(t, users, "jane")
+		joe := saveUser(t, users, "joe")
+		for _, f := range []*biz.Follow{{FollowerID: jane.ID, FolloweeID: joe.ID}, {FollowerID: joe.ID, FolloweeID: jane.ID}} {
+			if _, err := graph.Save(ctx, f); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := users.Delete(ctx, jane.ID, 2); !errors.Is(err, biz.ErrVersionConflict) {
+			t.Errorf("Delete() at a stale version = %v, want ErrVersionConflict", err)
+		}
+		if err := users.Delete(ctx, jane.ID, 1); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+		if _, err := users.FindByID(ctx, jane.ID); !errors.Is(err, biz.ErrUserNotFound) {
+			t.Errorf("FindByID() of a deleted user = %v, want ErrUserNotFound", err)
+		}
+		if err := users.Delete(ctx, jane.ID, 0); !errors.Is(err, biz.ErrUserNotFound) {
+			t.Errorf("Delete() of a deleted user = %v, want ErrUserNotFound", err)
+		}
+		u, err := users.FindByID(ctx, joe.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.FollowerCount != 0 || u.FollowingCount != 0 {
+			t.Errorf("counts of a peer of a deleted user = %d/%d, want 0/0", u.FollowerCount, u.FollowingCount)
+		}
+		if ok, err := graph.Exists(ctx, joe.ID, jane.ID); err != nil || ok {
+			t.Errorf("Exists() of a follow of a deleted user = %t, %v, want false", ok, err)
+		}
+	})
+}
+
+func TestUserRepoList(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, d *Data) {
+		users := NewUserRepo(d, log.DefaultLogger)
+		ctx := context.Background()
+		var want []int64
+		for _, name := range []string{"ann", "bob", "cat", "dan", "eve"} {
+			want = append(want, saveUser(t, users, name).ID)
+		}
+		var got []int64
+		page := &biz.Page{Size: 2}
+		for {
+			us, err := users.List(ctx, page)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(us) > page.Size+1 {
+				t.Fatalf("List() returned %d users for a page of %d", len(us), page.Size)
+			}
+			more := len(us) > page.Size
+			if more {
+				us = us[:page.Size]
+			}
+			for _, u := range us {
+				got = append(got, u.ID)
+			}
+			if !more {
+				break
+			}
+			page.After = &biz.Cursor{ID: us[len(us)-1].ID}
+		}
+		if !equalIDs(got, want) {
+			t.Errorf("List() pages = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestGraphRepo(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, d *Data) {
+		users := NewUserRepo(d, log.DefaultLogger)
+		graph := NewGraphRepo(d, log.DefaultLogger)
+		ctx := context.Background()
+		jane := saveUser(t, users, "jane")
+		joe := saveUser(t, users, "joe")
+		ann := saveUser(t, users, "ann")
+
+		for _, id := range []int64{joe.ID, ann.ID} {
+			if _, err := graph.Save(ctx, &biz.Follow{FollowerID: id, FolloweeID: jane.ID}); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+		}
+		if _, err := graph.Save(ctx, &biz.Follow{FollowerID: joe.ID, FolloweeID: jane.ID}); !errors.Is(err, biz.ErrAlreadyFollowing) {
+			t.Errorf("Save() of an existing follow = %v, want ErrAlreadyFollowing", err)
+		}
+		if ok, err := graph.Exists(ctx, joe.ID, jane.ID); err != nil || !ok {
+			t.Errorf("Exists() = %t, %v, want true", ok, err)
+		}
+		if ok, err := graph.Exists(ctx, jane.ID, joe.ID); err != nil || ok {
+			t.Errorf("Exists() of the reverse follow = %t, %v, want false", ok, err)
+		}
+		if u, err := users.FindByID(ctx, jane.ID); err != nil || u.FollowerCount != 2 || u.FollowingCount != 0 {
+			t.Errorf("counts of jane = %d/%d, %v, want 2/0", u.FollowerCount, u.FollowingCount, err)
+		}
+		if u, err := users.FindByID(ctx, joe.ID); err != nil || u.FollowerCount != 0 || u.FollowingCount != 1 {
+			t.Errorf("counts of joe = %d/%d, %v, want 0/1", u.FollowerCount, u.FollowingCount, err)
+		}
+
+		fs, err := graph.ListFollowers(ctx, jane.ID, &biz.Page{Size: 10})
+		if err != nil || len(fs) != 2 {
+			t.Fatalf("ListFollowers() = %v, %v, want 2 follows", fs, err)
+		}
+		after := &biz.Cursor{Time: fs[0].CreatedAt, ID: fs[0].FollowerID}
+		if rest, err := graph.ListFollowers(ctx, jane.ID, &biz.Page{Size: 1, After: after}); err != nil || len(rest) != 1 || rest[0].FollowerID != fs[1].FollowerID {
+			t.Errorf("ListFollowers() after %d = %v, %v, want %d", after.ID, rest, err, fs[1].FollowerID)
+		}
+		if fs, err := graph.ListFollowing(ctx, joe.ID, &biz.Page{Size: 10}); err != nil || len(fs) != 1 || fs[0].FolloweeID != jane.ID {
+			t.Errorf("ListFollowing() = %v, %v, want jane", fs, err)
+		}
+
+		if err := graph.Delete(ctx, joe.ID, jane.ID); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+		if err := graph.Delete(ctx, joe.ID, jane.ID); !errors.Is(err, biz.ErrNotFollowing) {
+			t.Errorf("Delete() of a missing follow = %v, want ErrNotFollowing", err)
+		}
+		if u, err := users.FindByID(ctx, jane.ID); err != nil || u.FollowerCount != 1 {
+			t.Errorf("follower count of jane = %d, %v, want 1", u.FollowerCount, err)
+		}
+		if n, err := graph.ReconcileCounts(ctx); err != nil || n != 0 {
+			t.Errorf("ReconcileCounts() = %d, %v, want nothing to repair", n, err)
+		}
+	})
+}
+
+func TestBlockRepo(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, d *Data) {
+		users := NewUserRepo(d, log.DefaultLogger)
+		blocks := NewBlockRepo(d, log.DefaultLogger)
+		ctx := context.Background()
+		jane := saveUser(t, users, "jane")
+		joe := saveUser(t, users, "joe")
+		ann := saveUser(t, users, "ann")
+
+		if _, err := blocks.Save(ctx, &biz.Block{BlockerID: jane.ID, BlockedID: joe.ID}); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+		if _, err := blocks.Save(ctx, &biz.Block{BlockerID: jane.ID, BlockedID: joe.ID}); !errors.Is(err, biz.ErrAlreadyBlocked) {
+			t.Errorf("Save() of an existing block = %v, want ErrAlreadyBlocked", err)
+		}
+		if ok, err := blocks.Exists(ctx, jane.ID, joe.ID); err != nil || !ok {
+			t.Errorf("Exists() = %t, %v, want true", ok, err)
+		}
+		if ids, err := blocks.Blockers(ctx, joe.ID, []int64{jane.ID, ann.ID}); err != nil || !equalIDs(ids, []int64{jane.ID}) {
+			t.Errorf("Blockers() = %v, %v, want [%d]", ids, err, jane.ID)
+		}
+		if err := blocks.Delete(ctx, jane.ID, joe.ID); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+		if err := blocks.Delete(ctx, jane.ID, joe.ID); !errors.Is(err, biz.ErrNotBlocked) {
+			t.Errorf("Delete() of a missing block = %v, want ErrNotBlocked", err)
+		}
+	})
+}
+
+func TestExecTxRollsBack(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, d *Data) {
+		users := NewUserRepo(d, log.DefaultLogger)
+		ctx := context.Background()
+		errRollback := errors.New("rollback")
+		err := d.ExecTx(ctx, func(ctx context.Context) error {
+			if _, err := users.Save(ctx, &biz.User{Username: "jane", Email: "jane@example.com"}); err != nil {
+				return err
+			}
+			return d.ExecTx(ctx, func(ctx context.Context) error { return errRollback })
+		})
+		if !errors.Is(err, errRollback) {
+			t.Fatalf("ExecTx() = %v, want %v", err, errRollback)
+		}
+		if _, err := users.FindByEmail(ctx, "jane@example.com"); !errors.Is(err, biz.ErrUserNotFound) {
+			t.Errorf("FindByEmail() after a rollback = %v, want ErrUserNotFound", err)
+		}
+		saveUser(t, users, "jane")
+	})
+}
+
+func TestPasswordResetRepoSave(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, d *Data) {
+		users := NewUserRepo(d, log.DefaultLogger)
+		resets := NewPasswordResetRepo(d, log.DefaultLogger)
+		ctx := context.Background()
+		jane := saveUser(t, users, "jane")
+		reset := func(hash string) *biz.PasswordReset {
+			return &biz.PasswordReset{UserID: jane.ID, TokenHash: hash, Email: jane.Email, ExpiresAt: time.Now().Add(time.Hour)}
+		}
+
+		// Of concurrent saves within the interval, only one is stored.
+		var (
+			wg    sync.WaitGroup
+			saved int32
+		)
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				ok, err := resets.Save(ctx, reset(fmt.Sprintf("hash-%d", i)), time.Now().Add(-time.Minute))
+				if err != nil {
+					t.Error(err)
+				}
+				if ok {
+					atomic.AddInt32(&saved, 1)
+				}
+			}(i)
+		}
+		wg.Wait()
+		if saved != 1 {
+			t.Errorf("%d concurrent Save() calls within the interval saved, want 1", saved)
+		}
+
+		if ok, err := resets.Save(ctx, reset("later"), time.Now()); err != nil || !ok {
+			t.Errorf("Save() after the interval = %t, %v, want saved", ok, err)
+		}
+		if pr, err := resets.Find(ctx, jane.ID); err != nil || pr.TokenHash != "later" {
+			t.Errorf("Find() = %+v, %v, want the replacing reset", pr, err)
+		}
+		if pr, err := resets.FindByTokenHash(ctx, "later"); err != nil || pr.UserID != jane.ID {
+			t.Errorf("FindByTokenHash() = %+v, %v, want the reset of jane", pr, err)
+		}
+		if _, err := resets.FindByTokenHash(ctx, "unknown"); !errors.Is(err, biz.ErrPasswordResetTokenInvalid) {
+			t.Errorf("FindByTokenHash() of an unknown hash = %v, want ErrPasswordResetTokenInvalid", err)
+		}
+	})
+}
+
+func TestTOTPRepoAddFailedAttempt(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, d *Data) {
+		if d.mem == nil {
+			d.secrets, _ = newSecretBox(base64.StdEncoding.EncodeToString(make([]byte, 32)))
+		}
+		users := NewUserRepo(d, log.DefaultLogger)
+		totps := NewTOTPRepo(d, log.DefaultLogger)
+		ctx := context.Background()
+		jane := saveUser(t, users, "jane")
+		if _, err := totps.Save(ctx, &biz.TOTP{UserID: jane.ID, Secret: []byte("secret")}); err != nil {
+			t.Fatal(err)
+		}
+
+		t0 := time.Now()
+		for i, at := range []time.Time{t0, t0.Add(time.Minute), t0.Add(2 * time.Minute)} {
+			n, err := totps.AddFailedAttempt(ctx, jane.ID, at, at.Add(-time.Hour))
+			if err != nil || n != int32(i+1) {
+				t.Errorf("AddFailedAttempt() %d = %d, %v, want %d", i, n, err, i+1)
+			}
+		}
+		tt, err := totps.Find(ctx, jane.ID)
+		if err != nil || tt.FailedAttempts != 3 || tt.FailedAt == nil || !tt.FailedAt.Equal(t0.Add(2*time.Minute).UTC().Truncate(time.Microsecond)) {
+			t.Errorf("Find() = %+v, %v, want 3 failed attempts, the latest at the last one", tt, err)
+		}
+		// The count starts anew once the latest attempt is before since.
+		if n, err := totps.AddFailedAttempt(ctx, jane.ID, t0.Add(time.Hour), t0.Add(3*time.Minute)); err != nil || n != 1 {
+			t.Errorf("AddFailedAttempt() after since = %d, %v, want 1", n, err)
+		}
+		if _, err := totps.AddFailedAttempt(ctx, jane.ID+1, t0, t0); !errors.Is(err, biz.ErrTOTPNotEnabled) {
+			t.Errorf("AddFailedAttempt() without a TOTP = %v, want ErrTOTPNotEnabled", err)
+		}
+	})
+}
+
+func TestUserDuplicateError(t *testing.T) {
+	// SQLite names the columns, so the errors are those of real inserts.
+	m, db := newTestMigrator(t)
+	if err := m.Up(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	insert := func(username, key, email string) error {
+		_, err := db.Exec("INSERT INTO users (username, username_key, email, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+			username, key, email, now(), now())
+		return err
+	}
+	if err := insert("jane", "jane", "jane@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	// MySQL names the index, after the values, which may hold anything.
+	mysqlDuplicate := func(msg string) error {
+		return fmt.Errorf("insert: %w", &mysql.MySQLError{Number: 1062, Message: msg})
+	}
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"sqlite email", insert("joe", "joe", "jane@example.com"), biz.ErrEmailTaken},
+		{"sqlite username", insert("jane", "other", "username@example.com"), biz.ErrUsernameTaken},
+		{"sqlite username key", insert("email", "jane", "other@example.com"), biz.ErrUsernameTaken},
+		{"mysql email", mysqlDuplicate("Duplicate entry 'username@example.com' for key 'users.uk_users_email'"), biz.ErrEmailTaken},
+		{"mysql 5.7 email", mysqlDuplicate("Duplicate entry 'jane@example.com' for key 'uk_users_email'"), biz.ErrEmailTaken},
+		{"mysql username", mysqlDuplicate("Duplicate entry 'email' for key 'users.uk_users_username'"), biz.ErrUsernameTaken},
+		{"mysql username key", mysqlDuplicate("Duplicate entry 'email' for key 'users.uk_users_username_key'"), biz.ErrUsernameTaken},
+		{"mysql primary key", mysqlDuplicate("Duplicate entry '1' for key 'users.PRIMARY'"), biz.ErrUserAlreadyExists},
+		{"mysql other error", &mysql.MySQLError{Number: 1452, Message: "Cannot add or update a child row: email"}, biz.ErrUserAlreadyExists},
+	}
+	for _, tt := range tests {
+		if tt.err == nil {
+			t.Errorf("%s: insert succeeded", tt.name)
+			continue
+		}
+		if got := userDuplicateError(tt.err); got != tt.want {
+			t.Errorf("%s: userDuplicateError(%q) = %v, want %v", tt.name, tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package data
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// sealedVersion prefixes every sealed secret, leaving room for other keys
+// or ciphers.
+const sealedVersion = 1
+
+// errNoEncryptionKey is a secret sealed or opened without data.encryption.key.
+var errNoEncryptionKey = errors.New("data: encryption.key is not configured")
+
+// secretBox seals the secrets stored at rest with AES-256-GCM. A nil
+// secretBox fails every call with errNoEncryptionKey.
+type secretBox struct {
+	aead cipher.AEAD
+}
+
+// newSecretBox returns the secretBox of a base64 key, or nil without one.
+func newSecretBox(key string) (*secretBox, error) {
+	if key == "" {
+		return nil, nil
+	}
+	k, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("data: encryption.key: %w", err)
+	}
+	if len(k) != 32 {
+		return nil, fmt.Errorf("data: encryption.key must be 32 bytes, got %d", len(k))
+	}
+	block, err := aes.NewCipher(k)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &secretBox{aead: aead}, nil
+}
+
+// seal encrypts plain bound to ad, which must be given again to open it.
+func (b *secretBox) seal(plain, ad []byte) ([]byte, error) {
+	if b == nil {
+		return nil, errNoEncryptionKey
+	}
+	out := make([]byte, 1+b.aead.NonceSize(), 1+b.aead.NonceSize()+len(plain)+b.aead.Overhead())
+	out[0] = sealedVersion
+	if _, err := rand.Read(out[1:]); err != nil {
+		return nil, err
+	}
+	return b.aead.Seal(out, out[1:], plain, ad), nil
+}
+
+func (b *secretBox) open(sealed, ad []byte) ([]byte, error) {
+	if b == nil {
+		return nil, errNoEncryptionKey
+	}
+	n := b.aead.NonceSize()
+	if len(sealed) < 1+n || sealed[0] != sealedVersion {
+		return nil, errors.New("data: malformed sealed secret")
+	}
+	return b.aead.Open(nil, sealed[1:1+n], sealed[1+n:], ad)
+}
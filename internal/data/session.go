@@ -0,0 +1,187 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const sessionColumns = "id, user_id, device, user_agent, ip, created_at, last_seen_at, expires_at, revoked_at, client_id, scope"
+
+// Columns are cut to their size, clients choose what they send.
+const (
+	maxDeviceLen    = 64
+	maxUserAgentLen = 255
+)
+
+type sessionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSessionRepo .
+func NewSessionRepo(data *Data, logger log.Logger) biz.SessionRepo {
+	if data.mem != nil {
+		return &memorySessionRepo{data: data}
+	}
+	return &sessionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *sessionRepo) Save(ctx context.Context, s *biz.Session) (*biz.Session, error) {
+	ns := newSession(s)
+	_, err := r.data.conn(ctx).ExecContext(ctx,
+		"INSERT INTO sessions ("+sessionColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, NULL, ?, ?)",
+		ns.ID, ns.UserID, ns.Device, ns.UserAgent, ns.IP, ns.CreatedAt, ns.LastSeenAt, ns.ExpiresAt, ns.ClientID, ns.Scope)
+	if err != nil {
+		return nil, err
+	}
+	return ns, nil
+}
+
+func (r *sessionRepo) Find(ctx context.Context, id string) (*biz.Session, error) {
+	s, err := scanSession(r.data.conn(ctx).QueryRowContext(ctx,
+		"SELECT "+sessionColumns+" FROM sessions WHERE id = ?", id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrSessionNotFound
+	}
+	return s, err
+}
+
+func (r *sessionRepo) List(ctx context.Context, userID int64, at time.Time) ([]*biz.Session, error) {
+	return r.query(ctx,
+		"SELECT "+sessionColumns+" FROM sessions WHERE user_id = ? AND revoked_at IS NULL AND expires_at > ? ORDER BY last_seen_at DESC, id",
+		userID, at.UTC())
+}
+
+func (r *sessionRepo) query(ctx context.Context, query string, args ...interface{}) ([]*biz.Session, error) {
+	rows, err := r.data.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ss []*biz.Session
+	for rows.Next() {
+		s, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		ss = append(ss, s)
+	}
+	return ss, rows.Err()
+}
+
+func scanSession(row scanner) (*biz.Session, error) {
+	var (
+		s       biz.Session
+		revoked sql.NullTime
+	)
+	if err := row.Scan(&s.ID, &s.UserID, &s.Device, &s.UserAgent, &s.IP, &s.CreatedAt, &s.LastSeenAt, &s.ExpiresAt, &revoked, &s.ClientID, &s.Scope); err != nil {
+		return nil, err
+	}
+	s.RevokedAt = nullTime(revoked)
+	return &s, nil
+}
+
+func (r *sessionRepo) Touch(ctx context.Context, id string, at time.Time, ip string, expiresAt time.Time) error {
+	set, args := "last_seen_at = ?", []interface{}{at.UTC().Truncate(time.Microsecond)}
+	if ip != "" {
+		set, args = set+", ip = ?", append(args, ip)
+	}
+	if !expiresAt.IsZero() {
+		set, args = set+", expires_at = ?", append(args, expiresAt.UTC().Truncate(time.Microsecond))
+	}
+	_, err := r.data.conn(ctx).ExecContext(ctx, "UPDATE sessions SET "+set+" WHERE id = ?", append(args, id)...)
+	return err
+}
+
+func (r *sessionRepo) Revoke(ctx context.Context, userID int64, id string, at time.Time) error {
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"UPDATE sessions SET revoked_at = ? WHERE id = ? AND user_id = ? AND revoked_at IS NULL", at.UTC(), id, userID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return biz.ErrSessionNotFound
+	}
+	return nil
+}
+
+func (r *sessionRepo) RevokeAll(ctx context.Context, userID int64, except string, at time.Time) ([]string, error) {
+	var ids []string
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		rows, err := r.data.conn(ctx).QueryContext(ctx,
+			"SELECT id FROM sessions WHERE user_id = ? AND id <> ? AND revoked_at IS NULL", userID, except)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		_, err = r.data.conn(ctx).ExecContext(ctx,
+			"UPDATE sessions SET revoked_at = ? WHERE user_id = ? AND id <> ? AND revoked_at IS NULL", at.UTC(), userID, except)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *sessionRepo) RevokeClient(ctx context.Context, clientID string, at time.Time) ([]*biz.Session, error) {
+	var ss []*biz.Session
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		var err error
+		ss, err = r.query(ctx, "SELECT "+sessionColumns+" FROM sessions WHERE client_id = ? AND revoked_at IS NULL", clientID)
+		if err != nil {
+			return err
+		}
+		_, err = r.data.conn(ctx).ExecContext(ctx,
+			"UPDATE sessions SET revoked_at = ? WHERE client_id = ? AND revoked_at IS NULL", at.UTC(), clientID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ss, nil
+}
+
+// newSession returns the row of a new session.
+func newSession(s *biz.Session) *biz.Session {
+	ns := *s
+	ns.Device = truncate(s.Device, maxDeviceLen)
+	ns.UserAgent = truncate(s.UserAgent, maxUserAgentLen)
+	ns.CreatedAt = now()
+	ns.LastSeenAt = ns.CreatedAt
+	ns.ExpiresAt = s.ExpiresAt.UTC().Truncate(time.Microsecond)
+	ns.RevokedAt = nil
+	return &ns
+}
+
+// truncate cuts s to at most n bytes without splitting a rune.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,76 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-redis/redis/v8"
+)
+
+func revokedSessionKey(id string) string {
+	return "session:revoked:" + id
+}
+
+// sessionCache is the biz.SessionCache. Revocations are kept in process,
+// which is all a single replica needs, and in redis when it is configured
+// so that every replica sees them.
+type sessionCache struct {
+	data *Data
+
+	mu    sync.Mutex
+	local map[string]time.Time
+	// purgedAt is when the expired local revocations were last removed.
+	purgedAt time.Time
+}
+
+// NewSessionCache .
+func NewSessionCache(data *Data) biz.SessionCache {
+	return &sessionCache{data: data, local: make(map[string]time.Time)}
+}
+
+func (c *sessionCache) Revoke(ctx context.Context, ids []string, ttl time.Duration) error {
+	t := time.Now()
+	c.mu.Lock()
+	for _, id := range ids {
+		c.local[id] = t.Add(ttl)
+	}
+	if t.Sub(c.purgedAt) > ttl {
+		for id, exp := range c.local {
+			if !exp.After(t) {
+				delete(c.local, id)
+			}
+		}
+		c.purgedAt = t
+	}
+	c.mu.Unlock()
+	if c.data.cache.rdb == nil {
+		return nil
+	}
+	_, err := c.data.cache.rdb.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for _, id := range ids {
+			p.Set(ctx, revokedSessionKey(id), 1, ttl)
+		}
+		return nil
+	})
+	return err
+}
+
+func (c *sessionCache) Revoked(ctx context.Context, id string) (bool, error) {
+	c.mu.Lock()
+	exp, ok := c.local[id]
+	c.mu.Unlock()
+	if ok && exp.After(time.Now()) {
+		return true, nil
+	}
+	if c.data.cache.rdb == nil {
+		return false, nil
+	}
+	err := c.data.cache.rdb.Get(ctx, revokedSessionKey(id)).Err()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,122 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"user/internal/biz"
+)
+
+// memorySessionRepo is the biz.SessionRepo of the memory driver.
+type memorySessionRepo struct {
+	data *Data
+}
+
+func (r *memorySessionRepo) Save(ctx context.Context, s *biz.Session) (*biz.Session, error) {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	if _, ok := m.users[s.UserID]; !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	ns := newSession(s)
+	m.sessions[ns.ID] = ns
+	c := *ns
+	return &c, nil
+}
+
+func (r *memorySessionRepo) Find(ctx context.Context, id string) (*biz.Session, error) {
+	m := r.data.mem
+	defer m.rlock(ctx)()
+	s, ok := m.sessions[id]
+	if !ok {
+		return nil, biz.ErrSessionNotFound
+	}
+	c := *s
+	return &c, nil
+}
+
+func (r *memorySessionRepo) List(ctx context.Context, userID int64, at time.Time) ([]*biz.Session, error) {
+	m := r.data.mem
+	defer m.rlock(ctx)()
+	var ss []*biz.Session
+	for _, s := range m.sessions {
+		if s.UserID == userID && s.RevokedAt == nil && s.ExpiresAt.After(at) {
+			c := *s
+			ss = append(ss, &c)
+		}
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		if !ss[i].LastSeenAt.Equal(ss[j].LastSeenAt) {
+			return ss[i].LastSeenAt.After(ss[j].LastSeenAt)
+		}
+		return ss[i].ID < ss[j].ID
+	})
+	return ss, nil
+}
+
+func (r *memorySessionRepo) Touch(ctx context.Context, id string, at time.Time, ip string, expiresAt time.Time) error {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	s, ok := m.sessions[id]
+	if !ok {
+		return nil
+	}
+	ns := *s
+	ns.LastSeenAt = at.UTC().Truncate(time.Microsecond)
+	if ip != "" {
+		ns.IP = ip
+	}
+	if !expiresAt.IsZero() {
+		ns.ExpiresAt = expiresAt.UTC().Truncate(time.Microsecond)
+	}
+	m.sessions[id] = &ns
+	return nil
+}
+
+func (r *memorySessionRepo) Revoke(ctx context.Context, userID int64, id string, at time.Time) error {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	s, ok := m.sessions[id]
+	if !ok || s.UserID != userID || s.RevokedAt != nil {
+		return biz.ErrSessionNotFound
+	}
+	m.revokeSession(s, at)
+	return nil
+}
+
+func (r *memorySessionRepo) RevokeAll(ctx context.Context, userID int64, except string, at time.Time) ([]string, error) {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	var ids []string
+	for id, s := range m.sessions {
+		if s.UserID == userID && id != except && s.RevokedAt == nil {
+			m.revokeSession(s, at)
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (r *memorySessionRepo) RevokeClient(ctx context.Context, clientID string, at time.Time) ([]*biz.Session, error) {
+	m := r.data.mem
+	defer m.lock(ctx)()
+	var ss []*biz.Session
+	for _, s := range m.sessions {
+		if s.ClientID == clientID && s.RevokedAt == nil {
+			c := *s
+			ss = append(ss, &c)
+			m.revokeSession(s, at)
+		}
+	}
+	return ss, nil
+}
+
+// revokeSession sets RevokedAt of a session. Callers must hold the write
+// lock.
+func (s *memoryStore) revokeSession(ses *biz.Session, at time.Time) {
+	ns := *ses
+	at = at.UTC()
+	ns.RevokedAt = &at
+	s.sessions[ns.ID] = &ns
+}
@@ -0,0 +1,151 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type totpRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTOTPRepo .
+func NewTOTPRepo(data *Data, logger log.Logger) biz.TOTPRepo {
+	if data.mem != nil {
+		return &memoryTOTPRepo{data: data}
+	}
+	return &totpRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// totpSecretAD binds a sealed secret to its user, so that it cannot be
+// moved to another row.
+func totpSecretAD(userID int64) []byte {
+	return []byte(fmt.Sprintf("totp:%d", userID))
+}
+
+func (r *totpRepo) Save(ctx context.Context, t *biz.TOTP) (*biz.TOTP, error) {
+	sealed, err := r.data.secrets.seal(t.Secret, totpSecretAD(t.UserID))
+	if err != nil {
+		return nil, err
+	}
+	nt := *t
+	nt.ConfirmedAt, nt.LastUsedStep, nt.FailedAttempts, nt.FailedAt, nt.CreatedAt = nil, 0, 0, nil, now()
+	err = r.data.ExecTx(ctx, func(ctx context.Context) error {
+		if err := r.Delete(ctx, nt.UserID); err != nil {
+			return err
+		}
+		_, err := r.data.conn(ctx).ExecContext(ctx,
+			"INSERT INTO totp_secrets (user_id, secret, last_used_step, created_at) VALUES (?, ?, ?, ?)",
+			nt.UserID, sealed, 0, nt.CreatedAt)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nt, nil
+}
+
+func (r *totpRepo) Find(ctx context.Context, userID int64) (*biz.TOTP, error) {
+	var (
+		t         = biz.TOTP{UserID: userID}
+		sealed    []byte
+		confirmed sql.NullTime
+		failed    sql.NullTime
+	)
+	err := r.data.conn(ctx).QueryRowContext(ctx,
+		"SELECT secret, confirmed_at, last_used_step, failed_attempts, failed_at, created_at FROM totp_secrets WHERE user_id = ?", userID).
+		Scan(&sealed, &confirmed, &t.LastUsedStep, &t.FailedAttempts, &failed, &t.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrTOTPNotEnabled
+	}
+	if err != nil {
+		return nil, err
+	}
+	if t.Secret, err = r.data.secrets.open(sealed, totpSecretAD(userID)); err != nil {
+		return nil, err
+	}
+	t.ConfirmedAt = nullTime(confirmed)
+	t.FailedAt = nullTime(failed)
+	return &t, nil
+}
+
+func (r *totpRepo) UseStep(ctx context.Context, userID, step int64, at time.Time) (bool, error) {
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"UPDATE totp_secrets SET last_used_step = ?, confirmed_at = COALESCE(confirmed_at, ?) WHERE user_id = ? AND last_used_step < ?",
+		step, at.UTC().Truncate(time.Microsecond), userID, step)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
+
+func (r *totpRepo) AddFailedAttempt(ctx context.Context, userID int64, at, since time.Time) (int32, error) {
+	var n int32
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		_, err := r.data.conn(ctx).ExecContext(ctx,
+			"UPDATE totp_secrets SET failed_attempts = CASE WHEN failed_at IS NULL OR failed_at < ? THEN 1 ELSE failed_attempts + 1 END, failed_at = ? WHERE user_id = ?",
+			since.UTC().Truncate(time.Microsecond), at.UTC().Truncate(time.Microsecond), userID)
+		if err != nil {
+			return err
+		}
+		err = r.data.conn(ctx).QueryRowContext(ctx,
+			"SELECT failed_attempts FROM totp_secrets WHERE user_id = ?", userID).Scan(&n)
+		if errors.Is(err, sql.ErrNoRows) {
+			return biz.ErrTOTPNotEnabled
+		}
+		return err
+	})
+	return n, err
+}
+
+func (r *totpRepo) Delete(ctx context.Context, userID int64) error {
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		for _, q := range []string{
+			"DELETE FROM recovery_codes WHERE user_id = ?",
+			"DELETE FROM totp_secrets WHERE user_id = ?",
+		} {
+			if _, err := r.data.conn(ctx).ExecContext(ctx, q, userID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *totpRepo) SaveRecoveryCodes(ctx context.Context, userID int64, hashes []string) error {
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		if _, err := r.data.conn(ctx).ExecContext(ctx, "DELETE FROM recovery_codes WHERE user_id = ?", userID); err != nil {
+			return err
+		}
+		t := now()
+		for _, h := range hashes {
+			_, err := r.data.conn(ctx).ExecContext(ctx,
+				"INSERT INTO recovery_codes (user_id, code_hash, created_at) VALUES (?, ?, ?)", userID, h, t)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *totpRepo) UseRecoveryCode(ctx context.Context, userID int64, hash string) (bool, error) {
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"DELETE FROM recovery_codes WHERE user_id = ? AND code_hash = ?", userID, hash)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
@@ -0,0 +1,128 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"user/internal/biz"
+)
+
+// recoveryCodeKey identifies a recovery code of the memory driver.
+type recoveryCodeKey struct {
+	userID int64
+	hash   string
+}
+
+// memoryTOTPRepo is the biz.TOTPRepo of the memory driver, which keeps the
+// secrets in the clear since nothing it holds is at rest.
+type memoryTOTPRepo struct {
+	data *Data
+}
+
+func (r *memoryTOTPRepo) Save(ctx context.Context, t *biz.TOTP) (*biz.TOTP, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	if _, ok := s.users[t.UserID]; !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	s.deleteTOTP(t.UserID)
+	nt := copyTOTP(t)
+	nt.ConfirmedAt, nt.LastUsedStep, nt.FailedAttempts, nt.FailedAt, nt.CreatedAt = nil, 0, 0, nil, now()
+	s.totps[nt.UserID] = nt
+	return copyTOTP(nt), nil
+}
+
+func (r *memoryTOTPRepo) Find(ctx context.Context, userID int64) (*biz.TOTP, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	t, ok := s.totps[userID]
+	if !ok {
+		return nil, biz.ErrTOTPNotEnabled
+	}
+	return copyTOTP(t), nil
+}
+
+func (r *memoryTOTPRepo) UseStep(ctx context.Context, userID, step int64, at time.Time) (bool, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	t, ok := s.totps[userID]
+	if !ok || t.LastUsedStep >= step {
+		return false, nil
+	}
+	nt := copyTOTP(t)
+	nt.LastUsedStep = step
+	if nt.ConfirmedAt == nil {
+		at = at.UTC().Truncate(time.Microsecond)
+		nt.ConfirmedAt = &at
+	}
+	s.totps[userID] = nt
+	return true, nil
+}
+
+func (r *memoryTOTPRepo) AddFailedAttempt(ctx context.Context, userID int64, at, since time.Time) (int32, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	t, ok := s.totps[userID]
+	if !ok {
+		return 0, biz.ErrTOTPNotEnabled
+	}
+	nt := copyTOTP(t)
+	if nt.FailedAt == nil || nt.FailedAt.Before(since) {
+		nt.FailedAttempts = 0
+	}
+	nt.FailedAttempts++
+	at = at.UTC().Truncate(time.Microsecond)
+	nt.FailedAt = &at
+	s.totps[userID] = nt
+	return nt.FailedAttempts, nil
+}
+
+func (r *memoryTOTPRepo) Delete(ctx context.Context, userID int64) error {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	s.deleteTOTP(userID)
+	return nil
+}
+
+func (r *memoryTOTPRepo) SaveRecoveryCodes(ctx context.Context, userID int64, hashes []string) error {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	s.deleteRecoveryCodes(userID)
+	t := now()
+	for _, h := range hashes {
+		s.recoveryCodes[recoveryCodeKey{userID, h}] = t
+	}
+	return nil
+}
+
+func (r *memoryTOTPRepo) UseRecoveryCode(ctx context.Context, userID int64, hash string) (bool, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	k := recoveryCodeKey{userID, hash}
+	if _, ok := s.recoveryCodes[k]; !ok {
+		return false, nil
+	}
+	delete(s.recoveryCodes, k)
+	return true, nil
+}
+
+// deleteTOTP removes the TOTP and recovery codes of a user. Callers must
+// hold the write lock.
+func (s *memoryStore) deleteTOTP(userID int64) {
+	delete(s.totps, userID)
+	s.deleteRecoveryCodes(userID)
+}
+
+func (s *memoryStore) deleteRecoveryCodes(userID int64) {
+	for k := range s.recoveryCodes {
+		if k.userID == userID {
+			delete(s.recoveryCodes, k)
+		}
+	}
+}
+
+func copyTOTP(t *biz.TOTP) *biz.TOTP {
+	c := *t
+	c.Secret = append([]byte(nil), t.Secret...)
+	return &c
+}
@@ -0,0 +1,389 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const userColumns = "id, username, email, email_verified, private, " + profileColumns + ", version, follower_count, following_count, created_at, updated_at"
+
+const profileColumns = "display_name, bio, avatar_url, location, website, birth_date, locale, timezone"
+
+// userPathColumns maps the update mask paths to their columns.
+var userPathColumns = map[string]string{
+	biz.PathUsername:           "username",
+	biz.PathEmail:              "email",
+	biz.PathPrivate:            "private",
+	biz.PathProfileDisplayName: "display_name",
+	biz.PathProfileBio:         "bio",
+	biz.PathProfileAvatarURL:   "avatar_url",
+	biz.PathProfileLocation:    "location",
+	biz.PathProfileWebsite:     "website",
+	biz.PathProfileBirthDate:   "birth_date",
+	biz.PathProfileLocale:      "locale",
+	biz.PathProfileTimezone:    "timezone",
+}
+
+// userCacheTTL bounds how long a cached user may outlive a missed
+// invalidation.
+const userCacheTTL = 10 * time.Minute
+
+func userCacheKey(id int64) string {
+	return fmt.Sprintf("user:%d", id)
+}
+
+type userRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewUserRepo .
+func NewUserRepo(data *Data, logger log.Logger) biz.UserRepo {
+	if data.mem != nil {
+		return &memoryUserRepo{data: data}
+	}
+	return &userRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *userRepo) Save(ctx context.Context, u *biz.User) (*biz.User, error) {
+	t := now()
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"INSERT INTO users (username, username_key, email, private, "+profileColumns+", created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		append(append([]interface{}{u.Username, biz.HandleKey(u.Username), u.Email, u.Private}, profileArgs(&u.Profile)...), t, t)...)
+	if isDuplicate(err) {
+		return nil, userDuplicateError(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	return &biz.User{ID: id, Username: u.Username, Email: u.Email, Private: u.Private, Profile: u.Profile, Version: 1, CreatedAt: t, UpdatedAt: t}, nil
+}
+
+// Update only sets the columns of the paths, so that concurrent updates of
+// other fields are kept, and only on the expected version.
+func (r *userRepo) Update(ctx context.Context, u *biz.User, paths []string) (*biz.User, error) {
+	var (
+		set  strings.Builder
+		args = make([]interface{}, 0, len(paths)+2)
+	)
+	for _, p := range paths {
+		set.WriteString(userPathColumns[p] + " = ?, ")
+		args = append(args, userPathValue(u, p))
+		switch p {
+		case biz.PathUsername:
+			set.WriteString("username_key = ?, ")
+			args = append(args, biz.HandleKey(u.Username))
+		case biz.PathEmail:
+			set.WriteString("email_verified = ?, ")
+			args = append(args, u.EmailVerified)
+		}
+	}
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"UPDATE users SET "+set.String()+"version = version + 1, updated_at = ? WHERE id = ? AND version = ?",
+		append(args, now(), u.ID, u.Version)...)
+	if isDuplicate(err) {
+		return nil, userDuplicateError(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return nil, err
+	} else if n == 0 {
+		return nil, r.versionError(ctx, u.ID)
+	}
+	r.data.cache.del(ctx, userCacheKey(u.ID))
+	return r.FindByID(ctx, u.ID)
+}
+
+// Delete deletes a user, whose follows go with it, and takes them off the
+// counts of the users on the other side in the same transaction.
+func (r *userRepo) Delete(ctx context.Context, id int64, version int64) error {
+	var peers []int64
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		var err error
+		if peers, err = r.followPeers(ctx, id); err != nil {
+			return err
+		}
+		for _, q := range []string{
+			"UPDATE users SET follower_count = follower_count - 1 WHERE id IN (SELECT followee_id FROM follows WHERE follower_id = ?)",
+			"UPDATE users SET following_count = following_count - 1 WHERE id IN (SELECT follower_id FROM follows WHERE followee_id = ?)",
+		} {
+			if _, err := r.data.conn(ctx).ExecContext(ctx, q, id); err != nil {
+				return err
+			}
+		}
+		res, err := r.data.conn(ctx).ExecContext(ctx,
+			"DELETE FROM users WHERE id = ? AND (? = 0 OR version = ?)", id, version, version)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return r.versionError(ctx, id)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	r.data.cache.del(ctx, userCacheKey(id))
+	for _, p := range peers {
+		r.data.cache.del(ctx, userCacheKey(p))
+	}
+	return nil
+}
+
+// versionError returns the error of a write to id matching no row, which is
+// ErrVersionConflict if the user exists at another version.
+func (r *userRepo) versionError(ctx context.Context, id int64) error {
+	var version int64
+	err := r.data.conn(ctx).QueryRowContext(ctx, "SELECT version FROM users WHERE id = ?", id).Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return biz.ErrUserNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return biz.ErrVersionConflict
+}
+
+// followPeers returns the users following or followed by id.
+func (r *userRepo) followPeers(ctx context.Context, id int64) ([]int64, error) {
+	rows, err := r.data.conn(ctx).QueryContext(ctx,
+		"SELECT followee_id FROM follows WHERE follower_id = ? UNION SELECT follower_id FROM follows WHERE followee_id = ?", id, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ids []int64
+	for rows.Next() {
+		var p int64
+		if err := rows.Scan(&p); err != nil {
+			return nil, err
+		}
+		ids = append(ids, p)
+	}
+	return ids, rows.Err()
+}
+
+// FindByID reads through the cache, except in a transaction, which must see
+// its own writes and must not cache what it may roll back.
+func (r *userRepo) FindByID(ctx context.Context, id int64) (*biz.User, error) {
+	_, inTx := ctx.Value(contextTxKey{}).(*sql.Tx)
+	var u *biz.User
+	if !inTx && r.data.cache.get(ctx, userCacheKey(id), &u) {
+		return u, nil
+	}
+	row := r.data.conn(ctx).QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE id = ?", id)
+	u, err := scanUser(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !inTx {
+		r.data.cache.set(ctx, userCacheKey(id), u, userCacheTTL)
+	}
+	return u, nil
+}
+
+func (r *userRepo) FindByEmail(ctx context.Context, email string) (*biz.User, error) {
+	row := r.data.conn(ctx).QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE email = ?", email)
+	u, err := scanUser(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrUserNotFound
+	}
+	return u, err
+}
+
+func (r *userRepo) FindByHandle(ctx context.Context, key string) (*biz.User, error) {
+	row := r.data.conn(ctx).QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE username_key = ?", key)
+	u, err := scanUser(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrUserNotFound
+	}
+	return u, err
+}
+
+// FindByIDs reads the cache for every id, and the database in a single
+// query for the misses, except in a transaction like FindByID.
+func (r *userRepo) FindByIDs(ctx context.Context, ids []int64) ([]*biz.User, error) {
+	_, inTx := ctx.Value(contextTxKey{}).(*sql.Tx)
+	us := make([]*biz.User, 0, len(ids))
+	misses := ids
+	if !inTx {
+		keys := make([]string, len(ids))
+		for i, id := range ids {
+			keys[i] = userCacheKey(id)
+		}
+		cached := make([]*biz.User, len(ids))
+		found := r.data.cache.mget(ctx, keys, func(i int) interface{} { return &cached[i] })
+		misses = make([]int64, 0, len(ids))
+		for i, id := range ids {
+			if found[i] {
+				us = append(us, cached[i])
+			} else {
+				misses = append(misses, id)
+			}
+		}
+	}
+	if len(misses) == 0 {
+		return us, nil
+	}
+	args := make([]interface{}, len(misses))
+	for i, id := range misses {
+		args[i] = id
+	}
+	loaded, err := r.query(ctx, "SELECT "+userColumns+" FROM users WHERE id IN ("+placeholders(len(misses))+")", args...)
+	if err != nil {
+		return nil, err
+	}
+	if !inTx {
+		keys := make([]string, len(loaded))
+		vs := make([]interface{}, len(loaded))
+		for i, u := range loaded {
+			keys[i], vs[i] = userCacheKey(u.ID), u
+		}
+		r.data.cache.mset(ctx, keys, vs, userCacheTTL)
+	}
+	return append(us, loaded...), nil
+}
+
+func (r *userRepo) FindByHandles(ctx context.Context, keys []string) ([]*biz.User, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	args := make([]interface{}, len(keys))
+	for i, k := range keys {
+		args[i] = k
+	}
+	return r.query(ctx, "SELECT "+userColumns+" FROM users WHERE username_key IN ("+placeholders(len(keys))+")", args...)
+}
+
+// query returns the users selected by query.
+func (r *userRepo) query(ctx context.Context, query string, args ...interface{}) ([]*biz.User, error) {
+	rows, err := r.data.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var us []*biz.User
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		us = append(us, u)
+	}
+	return us, rows.Err()
+}
+
+func (r *userRepo) List(ctx context.Context, page *biz.Page) ([]*biz.User, error) {
+	var after int64
+	if page.After != nil {
+		after = page.After.ID
+	}
+	return r.query(ctx, "SELECT "+userColumns+" FROM users WHERE id > ? ORDER BY id LIMIT ?", after, page.Size+1)
+}
+
+// userDuplicateError maps a unique violation on users to the error of the
+// offending index, which MySQL names and SQLite gives the column of.
+func userDuplicateError(err error) error {
+	switch duplicateKey(err) {
+	case "uk_users_email", "users.email":
+		return biz.ErrEmailTaken
+	case "uk_users_username", "uk_users_username_key", "users.username", "users.username_key":
+		return biz.ErrUsernameTaken
+	default:
+		return biz.ErrUserAlreadyExists
+	}
+}
+
+func (r *userRepo) VerifyEmail(ctx context.Context, id int64, email string) (*biz.User, error) {
+	res, err := r.data.conn(ctx).ExecContext(ctx,
+		"UPDATE users SET email_verified = TRUE, version = version + 1, updated_at = ? WHERE id = ? AND email = ?",
+		now(), id, email)
+	if err != nil {
+		return nil, err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return nil, err
+	} else if n == 0 {
+		return nil, biz.ErrUserNotFound
+	}
+	r.data.cache.del(ctx, userCacheKey(id))
+	return r.FindByID(ctx, id)
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanUser(s scanner) (*biz.User, error) {
+	var (
+		u         biz.User
+		p         = &u.Profile
+		birthDate sql.NullTime
+	)
+	if err := s.Scan(&u.ID, &u.Username, &u.Email, &u.EmailVerified, &u.Private,
+		&p.DisplayName, &p.Bio, &p.AvatarURL, &p.Location, &p.Website, &birthDate, &p.Locale, &p.Timezone, &u.Version,
+		&u.FollowerCount, &u.FollowingCount, &u.CreatedAt, &u.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if birthDate.Valid {
+		p.BirthDate = birthDate.Time.UTC()
+	}
+	return &u, nil
+}
+
+// userPathValue returns the column value of an update mask path.
+func userPathValue(u *biz.User, path string) interface{} {
+	switch path {
+	case biz.PathUsername:
+		return u.Username
+	case biz.PathEmail:
+		return u.Email
+	case biz.PathPrivate:
+		return u.Private
+	case biz.PathProfileDisplayName:
+		return u.Profile.DisplayName
+	case biz.PathProfileBio:
+		return u.Profile.Bio
+	case biz.PathProfileAvatarURL:
+		return u.Profile.AvatarURL
+	case biz.PathProfileLocation:
+		return u.Profile.Location
+	case biz.PathProfileWebsite:
+		return u.Profile.Website
+	case biz.PathProfileBirthDate:
+		return sql.NullTime{Time: u.Profile.BirthDate, Valid: !u.Profile.BirthDate.IsZero()}
+	case biz.PathProfileLocale:
+		return u.Profile.Locale
+	case biz.PathProfileTimezone:
+		return u.Profile.Timezone
+	}
+	panic("data: unknown user path " + path)
+}
+
+// profileArgs returns the values of profileColumns.
+func profileArgs(p *biz.Profile) []interface{} {
+	birthDate := sql.NullTime{Time: p.BirthDate, Valid: !p.BirthDate.IsZero()}
+	return []interface{}{p.DisplayName, p.Bio, p.AvatarURL, p.Location, p.Website, birthDate, p.Locale, p.Timezone}
+}
@@ -0,0 +1,254 @@
+package data
+
+import (
+	"context"
+	"sort"
+
+	"user/internal/biz"
+)
+
+// memoryUserRepo is the biz.UserRepo of the memory driver.
+type memoryUserRepo struct {
+	data *Data
+}
+
+func (r *memoryUserRepo) Save(ctx context.Context, u *biz.User) (*biz.User, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	if err := s.userConflict(0, u); err != nil {
+		return nil, err
+	}
+	t := now()
+	nu := copyUser(u)
+	nu.ID, nu.Version, nu.CreatedAt, nu.UpdatedAt = s.nextID("users"), 1, t, t
+	s.users[nu.ID] = nu
+	return copyUser(nu), nil
+}
+
+func (r *memoryUserRepo) Update(ctx context.Context, u *biz.User, paths []string) (*biz.User, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	old, ok := s.users[u.ID]
+	if !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	if old.Version != u.Version {
+		return nil, biz.ErrVersionConflict
+	}
+	nu := copyUser(old)
+	biz.CopyUserFields(nu, u, paths)
+	for _, p := range paths {
+		if p == biz.PathEmail {
+			nu.EmailVerified = u.EmailVerified
+		}
+	}
+	if err := s.userConflict(u.ID, nu); err != nil {
+		return nil, err
+	}
+	nu.Version, nu.UpdatedAt = old.Version+1, now()
+	s.users[nu.ID] = nu
+	return copyUser(nu), nil
+}
+
+func (r *memoryUserRepo) Delete(ctx context.Context, id int64, version int64) error {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	u, ok := s.users[id]
+	if !ok {
+		return biz.ErrUserNotFound
+	}
+	if version != 0 && u.Version != version {
+		return biz.ErrVersionConflict
+	}
+	s.deleteUser(id)
+	return nil
+}
+
+func (r *memoryUserRepo) FindByID(ctx context.Context, id int64) (*biz.User, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	u, ok := s.users[id]
+	if !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	return copyUser(u), nil
+}
+
+func (r *memoryUserRepo) FindByEmail(ctx context.Context, email string) (*biz.User, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	for _, u := range s.users {
+		if u.Email == email {
+			return copyUser(u), nil
+		}
+	}
+	return nil, biz.ErrUserNotFound
+}
+
+func (r *memoryUserRepo) FindByHandle(ctx context.Context, key string) (*biz.User, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	for _, u := range s.users {
+		if biz.HandleKey(u.Username) == key {
+			return copyUser(u), nil
+		}
+	}
+	return nil, biz.ErrUserNotFound
+}
+
+func (r *memoryUserRepo) VerifyEmail(ctx context.Context, id int64, email string) (*biz.User, error) {
+	s := r.data.mem
+	defer s.lock(ctx)()
+	old, ok := s.users[id]
+	if !ok || old.Email != email {
+		return nil, biz.ErrUserNotFound
+	}
+	nu := copyUser(old)
+	nu.EmailVerified, nu.Version, nu.UpdatedAt = true, old.Version+1, now()
+	s.users[id] = nu
+	return copyUser(nu), nil
+}
+
+func (r *memoryUserRepo) FindByIDs(ctx context.Context, ids []int64) ([]*biz.User, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	var us []*biz.User
+	for _, id := range ids {
+		if u, ok := s.users[id]; ok {
+			us = append(us, copyUser(u))
+		}
+	}
+	return us, nil
+}
+
+func (r *memoryUserRepo) FindByHandles(ctx context.Context, keys []string) ([]*biz.User, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	want := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		want[k] = true
+	}
+	var us []*biz.User
+	for _, u := range s.users {
+		if want[biz.HandleKey(u.Username)] {
+			us = append(us, copyUser(u))
+		}
+	}
+	return us, nil
+}
+
+func (r *memoryUserRepo) List(ctx context.Context, page *biz.Page) ([]*biz.User, error) {
+	s := r.data.mem
+	defer s.rlock(ctx)()
+	var after int64
+	if page.After != nil {
+		after = page.After.ID
+	}
+	var us []*biz.User
+	for _, u := range s.users {
+		if u.ID > after {
+			us = append(us, u)
+		}
+	}
+	sort.Slice(us, func(i, j int) bool { return us[i].ID < us[j].ID })
+	if len(us) > page.Size+1 {
+		us = us[:page.Size+1]
+	}
+	for i, u := range us {
+		us[i] = copyUser(u)
+	}
+	return us, nil
+}
+
+// userConflict returns the error of a unique column u shares with another
+// user than id. Callers must hold the lock.
+func (s *memoryStore) userConflict(id int64, u *biz.User) error {
+	for _, o := range s.users {
+		if o.ID == id {
+			continue
+		}
+		if biz.HandleKey(o.Username) == biz.HandleKey(u.Username) {
+			return biz.ErrUsernameTaken
+		}
+		if o.Email == u.Email {
+			return biz.ErrEmailTaken
+		}
+	}
+	return nil
+}
+
+// deleteUser removes a user and, like the foreign keys of the SQL schema,
+// every row referencing it. Callers must hold the write lock.
+func (s *memoryStore) deleteUser(id int64) {
+	delete(s.users, id)
+	delete(s.credentials, id)
+	for k, t := range s.refreshTokens {
+		if t.UserID == id {
+			delete(s.refreshTokens, k)
+		}
+	}
+	for k := range s.follows {
+		switch id {
+		case k.from:
+			s.addFollowCounts(k.to, -1, 0)
+		case k.to:
+			s.addFollowCounts(k.from, 0, -1)
+		default:
+			continue
+		}
+		delete(s.follows, k)
+	}
+	for k := range s.blocks {
+		if k.from == id || k.to == id {
+			delete(s.blocks, k)
+		}
+	}
+	for k := range s.mutes {
+		if k.from == id || k.to == id {
+			delete(s.mutes, k)
+		}
+	}
+	for k := range s.followRequests {
+		if k.from == id || k.to == id {
+			delete(s.followRequests, k)
+		}
+	}
+	for k, c := range s.handleChanges {
+		if c.UserID == id {
+			delete(s.handleChanges, k)
+		}
+	}
+	delete(s.emailVerifications, id)
+	delete(s.passwordResets, id)
+	s.deleteTOTP(id)
+	for k, c := range s.loginChallenges {
+		if c.UserID == id {
+			delete(s.loginChallenges, k)
+		}
+	}
+	for k, ses := range s.sessions {
+		if ses.UserID == id {
+			delete(s.sessions, k)
+		}
+	}
+	for k, c := range s.authorizationCodes {
+		if c.UserID == id {
+			delete(s.authorizationCodes, k)
+		}
+	}
+	for k, li := range s.linkedIdentities {
+		if li.UserID == id {
+			delete(s.linkedIdentities, k)
+		}
+	}
+	for k, ak := range s.apiKeys {
+		if ak.UserID == id {
+			delete(s.apiKeys, k)
+		}
+	}
+}
+
+func copyUser(u *biz.User) *biz.User {
+	c := *u
+	return &c
+}
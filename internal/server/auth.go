@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// publicOperations can be called without an access token.
+var publicOperations = map[string]bool{
+	"/user.v1.Auth/Register":             true,
+	"/user.v1.Auth/Login":                true,
+	"/user.v1.Auth/VerifySecondFactor":   true,
+	"/user.v1.Auth/RefreshToken":         true,
+	"/user.v1.Auth/VerifyEmail":          true,
+	"/user.v1.Auth/RequestPasswordReset": true,
+	"/user.v1.Auth/ConfirmPasswordReset": true,
+	"/user.v1.Auth/StartFederatedLogin":  true,
+	"/user.v1.Auth/FinishFederatedLogin": true,
+}
+
+// delegatedScopes are the scopes API keys and the tokens of OAuth clients
+// need for the operations they may call. They may not call the others,
+// which manage credentials, clients and the account itself.
+var delegatedScopes = map[string]string{
+	"/user.v1.User/GetUser":               biz.ScopeUsersRead,
+	"/user.v1.User/GetUserByHandle":       biz.ScopeUsersRead,
+	"/user.v1.User/BatchGetUsers":         biz.ScopeUsersRead,
+	"/user.v1.User/BatchGetUsersByHandle": biz.ScopeUsersRead,
+	"/user.v1.User/ListUsers":             biz.ScopeUsersRead,
+	"/user.v1.User/UpdateUser":            biz.ScopeUsersWrite,
+	"/user.v1.User/ChangeHandle":          biz.ScopeUsersWrite,
+	"/user.v1.Graph/ListFollowers":        biz.ScopeGraphRead,
+	"/user.v1.Graph/ListFollowing":        biz.ScopeGraphRead,
+	"/user.v1.Graph/IsFollowing":          biz.ScopeGraphRead,
+	"/user.v1.Graph/ListFollowRequests":   biz.ScopeGraphRead,
+	"/user.v1.Graph/CheckVisibility":      biz.ScopeGraphRead,
+	"/user.v1.Graph/Follow":               biz.ScopeGraphWrite,
+	"/user.v1.Graph/Unfollow":             biz.ScopeGraphWrite,
+	"/user.v1.Graph/ApproveFollowRequest": biz.ScopeGraphWrite,
+	"/user.v1.Graph/RejectFollowRequest":  biz.ScopeGraphWrite,
+	"/user.v1.Graph/Block":                biz.ScopeGraphWrite,
+	"/user.v1.Graph/Unblock":              biz.ScopeGraphWrite,
+	"/user.v1.Graph/Mute":                 biz.ScopeGraphWrite,
+	"/user.v1.Graph/Unmute":               biz.ScopeGraphWrite,
+}
+
+// authenticate is a server middleware that validates the bearer access
+// token or API key of every non-public operation, rejects tokens whose
+// session was revoked and the keys and client tokens without the scope of
+// the operation, and puts the principal into the context. The
+// client_credentials tokens of OAuth clients, which have no user, are
+// refused by the operations needing one.
+func authenticate(tokens *biz.TokenManager, sessions *biz.Sessions, apiKeys *biz.APIKeys) middleware.Middleware {
+	return selector.Server(func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, biz.ErrUnauthenticated
+			}
+			p, err := checkBearer(ctx, tokens, sessions, apiKeys, tr.RequestHeader().Get("Authorization"))
+			if err != nil {
+				return nil, err
+			}
+			if p.Delegated() {
+				scope, ok := delegatedScopes[tr.Operation()]
+				if !ok {
+					return nil, biz.ErrPermissionDenied
+				}
+				if !p.HasScope(scope) {
+					return nil, biz.ErrPermissionDenied.WithMetadata(map[string]string{"scope": scope})
+				}
+			}
+			return handler(biz.NewPrincipalContext(ctx, p), req)
+		}
+	}).Match(func(ctx context.Context, operation string) bool {
+		return !publicOperations[operation]
+	}).Build()
+}
+
+// authenticateHandler wraps the plain HTTP handlers, which the server
+// middlewares do not run for, to put the client and, if the request has a
+// bearer access token, the user id into the context. Requests with an
+// invalid token are rejected, and so are API keys and client_credentials
+// tokens, which none of the handlers take.
+func authenticateHandler(tokens *biz.TokenManager, sessions *biz.Sessions, apiKeys *biz.APIKeys) func(http.HandlerFunc) http.HandlerFunc {
+	return func(h http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			ctx := biz.NewClientContext(r.Context(), &biz.Client{IP: hostOf(r.RemoteAddr), UserAgent: r.UserAgent()})
+			if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+				p, err := checkBearer(ctx, tokens, sessions, apiKeys, auth)
+				if err == nil && (p.APIKeyID != 0 || p.ClientCredentials()) {
+					err = biz.ErrTokenInvalid
+				}
+				if err != nil {
+					w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+					khttp.DefaultErrorEncoder(w, r, err)
+					return
+				}
+				ctx = biz.NewPrincipalContext(ctx, p)
+			}
+			h(w, r.WithContext(ctx))
+		}
+	}
+}
+
+// checkBearer returns the principal of the bearer access token or API key
+// of an Authorization header, unless the session of the token was revoked.
+// client_credentials tokens have no session to check.
+func checkBearer(ctx context.Context, tokens *biz.TokenManager, sessions *biz.Sessions, apiKeys *biz.APIKeys, auth string) (*biz.Principal, error) {
+	token := strings.TrimPrefix(auth, "Bearer ")
+	if auth == "" || token == auth {
+		return nil, biz.ErrUnauthenticated
+	}
+	if biz.IsAPIKey(token) {
+		return apiKeys.Check(ctx, token)
+	}
+	p, err := tokens.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+	if p.ClientCredentials() {
+		return p, nil
+	}
+	if err := sessions.Check(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
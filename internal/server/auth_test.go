@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	_ "user/api/user/v1"
+	"user/internal/biz"
+	"user/internal/conf"
+	"user/internal/data"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const testSecret = "test-secret"
+
+// testTransport is the transport of a request to an operation.
+type testTransport struct {
+	operation string
+	header    http.Header
+	reply     http.Header
+}
+
+func (t *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *testTransport) Endpoint() string                { return "" }
+func (t *testTransport) Operation() string               { return t.operation }
+func (t *testTransport) RequestHeader() transport.Header { return headerCarrier(t.header) }
+func (t *testTransport) ReplyHeader() transport.Header   { return headerCarrier(t.reply) }
+
+type headerCarrier http.Header
+
+func (h headerCarrier) Get(key string) string        { return http.Header(h).Get(key) }
+func (h headerCarrier) Set(key string, value string) { http.Header(h).Set(key, value) }
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func TestDelegatedScopes(t *testing.T) {
+	for op, scope := range delegatedScopes {
+		name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(op, "/"), "/", "."))
+		if _, err := protoregistry.GlobalFiles.FindDescriptorByName(name); err != nil {
+			t.Errorf("%s: no such operation", op)
+		}
+		if !strings.HasPrefix(op, "/user.v1.User/") && !strings.HasPrefix(op, "/user.v1.Graph/") {
+			t.Errorf("%s: delegated principals may only call the User and Graph services", op)
+		}
+		var known bool
+		for _, s := range biz.APIKeyScopes {
+			known = known || s == scope
+		}
+		if !known {
+			t.Errorf("%s: unknown scope %q", op, scope)
+		}
+	}
+	for _, op := range []string{"/user.v1.User/DeleteUser"} {
+		if _, ok := delegatedScopes[op]; ok {
+			t.Errorf("%s: delegated principals may call it", op)
+		}
+	}
+}
+
+// testAuth is what the authentication middlewares use, over a new
+// in-memory database.
+type testAuth struct {
+	tokens   *biz.TokenManager
+	sessions *biz.Sessions
+	apiKeys  *biz.APIKeys
+	cache    biz.SessionCache
+}
+
+func newTestAuth(t *testing.T) *testAuth {
+	t.Helper()
+	logger := log.DefaultLogger
+	d, cleanup, err := data.NewData(&conf.Data{Database: &conf.Data_Database{Driver: "memory"}}, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	tokens, err := biz.NewTokenManager(&conf.Auth{Jwt: &conf.Auth_JWT{Secret: testSecret, Issuer: "user"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := data.NewSessionCache(d)
+	return &testAuth{
+		tokens:   tokens,
+		sessions: biz.NewSessions(data.NewSessionRepo(d, logger), data.NewRefreshTokenRepo(d, logger), cache, tokens, logger),
+		apiKeys:  biz.NewAPIKeys(data.NewAPIKeyRepo(d, logger), logger),
+		cache:    cache,
+	}
+}
+
+// call calls an operation through the authenticate middleware with an
+// Authorization header, and returns the principal of the request.
+func (a *testAuth) call(op, auth string) (interface{}, error) {
+	handler := authenticate(a.tokens, a.sessions, a.apiKeys)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		p, _ := biz.PrincipalFromContext(ctx)
+		return p, nil
+	})
+	tr := &testTransport{operation: op, header: http.Header{}, reply: http.Header{}}
+	if auth != "" {
+		tr.header.Set("Authorization", auth)
+	}
+	return handler(transport.NewServerContext(context.Background(), tr), nil)
+}
+
+// serve serves a request with an Authorization header through
+// authenticateHandler, and returns the status of the reply.
+func (a *testAuth) serve(auth string) int {
+	h := authenticateHandler(a.tokens, a.sessions, a.apiKeys)(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	r := httptest.NewRequest(http.MethodGet, "/oauth2/userinfo", nil)
+	r.Header.Set("Authorization", auth)
+	w := httptest.NewRecorder()
+	h(w, r)
+	return w.Code
+}
+
+func TestAuthenticateRejectsRevokedSessions(t *testing.T) {
+	a := newTestAuth(t)
+	at, err := a.tokens.Issue(1, "session")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bearer := "Bearer " + at.Token
+	if p, err := a.call("/user.v1.User/GetUser", bearer); err != nil || p.(*biz.Principal).SessionID != "session" {
+		t.Fatalf("authenticate() = %v, %v, want the principal of the token", p, err)
+	}
+	if _, err := a.call("/user.v1.User/GetUser", ""); !errors.Is(err, biz.ErrUnauthenticated) {
+		t.Errorf("authenticate() without a token = %v, want ErrUnauthenticated", err)
+	}
+	if _, err := a.call("/user.v1.Auth/Login", ""); err != nil {
+		t.Errorf("authenticate() of a public operation = %v", err)
+	}
+	if err := a.cache.Revoke(context.Background(), []string{"session"}, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.call("/user.v1.User/GetUser", bearer); !errors.Is(err, biz.ErrSessionRevoked) {
+		t.Errorf("authenticate() of a revoked session = %v, want ErrSessionRevoked", err)
+	}
+}
+
+// clientToken signs a client_credentials access token of clientID with the
+// secret of newTestAuth, as the token endpoint would.
+func clientToken(t *testing.T, clientID, scope string) string {
+	t.Helper()
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": "user", "sub": clientID, "client_id": clientID, "scope": scope,
+		"iat": now.Unix(), "exp": now.Add(time.Minute).Unix(),
+	})
+	token.Header["typ"] = "at+jwt"
+	s, err := token.SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestAuthenticateClientCredentials(t *testing.T) {
+	a := newTestAuth(t)
+	bearer := "Bearer " + clientToken(t, "c1", biz.ScopeUsersRead)
+
+	p, err := a.call("/user.v1.User/GetUser", bearer)
+	if err != nil {
+		t.Fatalf("authenticate() of an operation of the scope = %v", err)
+	}
+	if p := p.(*biz.Principal); !p.ClientCredentials() || p.ClientID != "c1" {
+		t.Errorf("authenticate() = %+v, want the client", p)
+	}
+	if _, err := a.call("/user.v1.Graph/ListFollowers", bearer); !errors.Is(err, biz.ErrPermissionDenied) {
+		t.Errorf("authenticate() of an operation of another scope = %v, want ErrPermissionDenied", err)
+	}
+	if _, err := a.call("/user.v1.User/DeleteUser", bearer); !errors.Is(err, biz.ErrPermissionDenied) {
+		t.Errorf("authenticate() of a non-delegated operation = %v, want ErrPermissionDenied", err)
+	}
+	if _, err := a.call("/user.v1.User/GetUser", "Bearer "+clientToken(t, "", biz.ScopeUsersRead)); !errors.Is(err, biz.ErrTokenInvalid) {
+		t.Errorf("authenticate() of a token of no client = %v, want ErrTokenInvalid", err)
+	}
+	if code := a.serve(bearer); code != http.StatusUnauthorized {
+		t.Errorf("authenticateHandler() of a client token = %d, want %d", code, http.StatusUnauthorized)
+	}
+}
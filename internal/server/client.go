@@ -0,0 +1,41 @@
+package server
+
+import (
+	"context"
+	"net"
+
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+// clientInfo is a server middleware that puts the address and user agent
+// of the client into the context. The address is the peer of the
+// connection, forwarding headers are not trusted.
+func clientInfo() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			c := &biz.Client{}
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				c.UserAgent = tr.RequestHeader().Get("User-Agent")
+				if ht, ok := tr.(*http.Transport); ok {
+					c.IP = hostOf(ht.Request().RemoteAddr)
+				}
+			}
+			if p, ok := peer.FromContext(ctx); ok && c.IP == "" {
+				c.IP = hostOf(p.Addr.String())
+			}
+			return handler(biz.NewClientContext(ctx, c), req)
+		}
+	}
+}
+
+// hostOf strips the port of an address.
+func hostOf(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
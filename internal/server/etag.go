@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	v1 "user/api/user/v1"
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// etag is a server middleware that sets the ETag header of the replies
+// carrying a user to its version, and makes the If-Match header of user
+// updates and deletions their expected version. A stale If-Match fails
+// with 412 Precondition Failed, and a stale version of the body with 409.
+func etag() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			match := tr.RequestHeader().Get("If-Match")
+			if match != "" {
+				version, err := parseETag(match)
+				if err != nil {
+					return nil, preconditionFailed(err)
+				}
+				switch r := req.(type) {
+				case *v1.UpdateUserRequest:
+					if r.Version == 0 {
+						r.Version = version
+					}
+				case *v1.DeleteUserRequest:
+					if r.Version == 0 {
+						r.Version = version
+					}
+				}
+			}
+			reply, err := handler(ctx, req)
+			if err != nil && match != "" {
+				return nil, preconditionFailed(err)
+			}
+			if err != nil {
+				return nil, err
+			}
+			if r, ok := reply.(interface{ GetUser() *v1.UserInfo }); ok && r.GetUser() != nil {
+				tr.ReplyHeader().Set("ETag", formatETag(r.GetUser().Version))
+			}
+			return reply, nil
+		}
+	}
+}
+
+// preconditionFailed turns a version conflict into the failed
+// precondition of an If-Match header, keeping its reason.
+func preconditionFailed(err error) error {
+	if !errors.Is(err, biz.ErrVersionConflict) {
+		return err
+	}
+	se := errors.FromError(err)
+	return errors.New(http.StatusPreconditionFailed, se.Reason, se.Message).WithMetadata(se.Metadata)
+}
+
+func formatETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// parseETag returns the version of an If-Match header, 0 for "*". Other
+// lists and weak tags never match the stored version.
+func parseETag(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "*" {
+		return 0, nil
+	}
+	v, err := strconv.Unquote(s)
+	if err != nil || !strings.HasPrefix(s, `"`) {
+		return 0, biz.ErrVersionConflict
+	}
+	version, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, biz.ErrVersionConflict
+	}
+	return version, nil
+}
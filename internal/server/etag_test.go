@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	v1 "user/api/user/v1"
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+func TestETag(t *testing.T) {
+	// The handler stores a user at version, as UpdateUser does.
+	version := int64(1)
+	handler := etag()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		r := req.(*v1.UpdateUserRequest)
+		if r.Version != 0 && r.Version != version {
+			return nil, biz.ErrVersionConflict
+		}
+		version++
+		return &v1.UpdateUserReply{User: &v1.UserInfo{Id: r.Id, Version: version}}, nil
+	})
+	update := func(ifMatch string, bodyVersion int64) (string, error) {
+		tr := &testTransport{operation: "/user.v1.User/UpdateUser", header: http.Header{}, reply: http.Header{}}
+		if ifMatch != "" {
+			tr.header.Set("If-Match", ifMatch)
+		}
+		_, err := handler(transport.NewServerContext(context.Background(), tr), &v1.UpdateUserRequest{Id: 1, Version: bodyVersion})
+		return tr.reply.Get("ETag"), err
+	}
+
+	etag, err := update("", 0)
+	if err != nil || etag != `"2"` {
+		t.Fatalf("update() = %s, %v, want ETag \"2\"", etag, err)
+	}
+	if etag, err = update(etag, 0); err != nil || etag != `"3"` {
+		t.Fatalf("update() with If-Match of the current version = %s, %v, want ETag \"3\"", etag, err)
+	}
+	if etag, err = update("*", 0); err != nil || etag != `"4"` {
+		t.Fatalf("update() with If-Match * = %s, %v, want ETag \"4\"", etag, err)
+	}
+	for _, ifMatch := range []string{`"3"`, `W/"4"`, "4", `"4", "5"`} {
+		etag, err := update(ifMatch, 0)
+		if se := errors.FromError(err); se.Code != http.StatusPreconditionFailed || se.Reason != v1.ErrorReason_VERSION_CONFLICT.String() {
+			t.Errorf("update() with If-Match %s = %v, want 412 VERSION_CONFLICT", ifMatch, err)
+		}
+		if etag != "" {
+			t.Errorf("update() with If-Match %s set ETag %s", ifMatch, etag)
+		}
+	}
+	if _, err := update("", 3); !errors.Is(err, biz.ErrVersionConflict) {
+		t.Errorf("update() of a stale version of the body = %v, want ErrVersionConflict", err)
+	}
+	if version != 4 {
+		t.Errorf("version = %d after the failed updates, want 4", version)
+	}
+}
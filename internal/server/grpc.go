@@ -1,19 +1,24 @@
 package server
 
 import (
-	v1 "user/api/helloworld/v1"
+	v1 "user/api/user/v1"
+	"user/internal/biz"
 	"user/internal/conf"
 	"user/internal/service"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, user *service.UserService, auth *service.AuthService, graph *service.GraphService, oauth *service.OAuthService, tokens *biz.TokenManager, sessions *biz.Sessions, apiKeys *biz.APIKeys, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			clientInfo(),
+			authenticate(tokens, sessions, apiKeys),
+			validate.Validator(),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -26,6 +31,9 @@ func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, logger log.L
 		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
 	}
 	srv := grpc.NewServer(opts...)
-	v1.RegisterGreeterServer(srv, greeter)
+	v1.RegisterUserServer(srv, user)
+	v1.RegisterAuthServer(srv, auth)
+	v1.RegisterGraphServer(srv, graph)
+	v1.RegisterOAuthAdminServer(srv, oauth)
 	return srv
 }
@@ -1,19 +1,25 @@
 package server
 
 import (
-	v1 "user/api/helloworld/v1"
+	v1 "user/api/user/v1"
+	"user/internal/biz"
 	"user/internal/conf"
 	"user/internal/service"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
 // NewHTTPServer new a HTTP server.
-func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, user *service.UserService, auth *service.AuthService, graph *service.GraphService, oauth *service.OAuthService, tokens *biz.TokenManager, sessions *biz.Sessions, apiKeys *biz.APIKeys, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			clientInfo(),
+			authenticate(tokens, sessions, apiKeys),
+			validate.Validator(),
+			etag(),
 		),
 	}
 	if c.Http.Network != "" {
@@ -26,6 +32,17 @@ func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, logger log.L
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
 	srv := http.NewServer(opts...)
-	v1.RegisterGreeterHTTPServer(srv, greeter)
+	v1.RegisterUserHTTPServer(srv, user)
+	v1.RegisterAuthHTTPServer(srv, auth)
+	v1.RegisterGraphHTTPServer(srv, graph)
+	v1.RegisterOAuthAdminHTTPServer(srv, oauth)
+	// The endpoints of the authorization server are plain handlers, their
+	// requests and replies are not protobuf messages.
+	handle := authenticateHandler(tokens, sessions, apiKeys)
+	srv.HandleFunc("/.well-known/openid-configuration", oauth.Discovery)
+	srv.HandleFunc("/oauth2/jwks", oauth.JWKS)
+	srv.HandleFunc("/oauth2/authorize", handle(oauth.Authorize))
+	srv.HandleFunc("/oauth2/token", handle(oauth.Token))
+	srv.HandleFunc("/oauth2/userinfo", handle(oauth.UserInfo))
 	return srv
 }
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"user/internal/biz"
+	"user/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const defaultReconcileCountsInterval = time.Hour
+
+// JobServer runs the periodic background jobs of the service, and the
+// handling of password reset requests. It is a transport.Server so that the
+// app starts and stops it with the others.
+type JobServer struct {
+	interval time.Duration
+	graph    *biz.GraphUsecase
+	resets   *biz.PasswordResetUsecase
+	log      *log.Helper
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewJobServer new a background job server.
+func NewJobServer(c *conf.Server, graph *biz.GraphUsecase, resets *biz.PasswordResetUsecase, logger log.Logger) *JobServer {
+	s := &JobServer{
+		interval: defaultReconcileCountsInterval,
+		graph:    graph,
+		resets:   resets,
+		log:      log.NewHelper(logger),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	if d := c.GetJob().GetReconcileCountsInterval(); d != nil && d.AsDuration() > 0 {
+		s.interval = d.AsDuration()
+	}
+	return s
+}
+
+// Start runs the jobs until Stop is called.
+func (s *JobServer) Start(ctx context.Context) error {
+	defer close(s.done)
+	resetsCtx, cancel := context.WithCancel(ctx)
+	resetsDone := make(chan struct{})
+	go func() {
+		defer close(resetsDone)
+		s.resets.HandlePasswordResets(resetsCtx)
+	}()
+	defer func() {
+		cancel()
+		<-resetsDone
+	}()
+	s.log.Infof("[Job] reconciling follow counts and purging follow requests every %s", s.interval)
+	t := time.NewTicker(s.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return nil
+		case <-t.C:
+			if _, err := s.graph.ReconcileCounts(ctx); err != nil {
+				s.log.Errorf("[Job] reconcile follow counts: %v", err)
+			}
+			if _, err := s.graph.PurgeExpiredFollowRequests(ctx); err != nil {
+				s.log.Errorf("[Job] purge expired follow requests: %v", err)
+			}
+		}
+	}
+}
+
+// Stop stops the jobs, and waits for a running one and the password reset
+// being handled to finish.
+func (s *JobServer) Stop(ctx context.Context) error {
+	close(s.stop)
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"user/internal/biz"
+	"user/internal/conf"
+	"user/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestJobServerReconcilesCounts(t *testing.T) {
+	logger := log.DefaultLogger
+	ctx := context.Background()
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Source: filepath.Join(t.TempDir(), "user.db")}}
+	m, cleanup, err := data.NewMigrator(c, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = m.Up(ctx)
+	cleanup()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, cleanup, err := data.NewData(c, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+
+	users := data.NewUserRepo(d, logger)
+	follows := data.NewGraphRepo(d, logger)
+	var ids []int64
+	for _, name := range []string{"jane", "joe"} {
+		u, err := users.Save(ctx, &biz.User{Username: name, Email: name + "@example.com"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, u.ID)
+	}
+	if _, err := follows.Save(ctx, &biz.Follow{FollowerID: ids[1], FolloweeID: ids[0]}); err != nil {
+		t.Fatal(err)
+	}
+	// Skew the counts behind the back of the service, as a crash between
+	// writes would.
+	db, err := sql.Open("sqlite", c.Database.Source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec("UPDATE users SET follower_count = 7, following_count = 7")
+	db.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ac := &conf.Auth{Jwt: &conf.Auth_JWT{Secret: testSecret}}
+	tokens, err := biz.NewTokenManager(ac)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pages, err := biz.NewPaginator(&conf.Pagination{TokenSecret: testSecret}, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mailer, err := data.NewMailer(&conf.Mail{}, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := data.NewTransaction(d)
+	refreshTokens := data.NewRefreshTokenRepo(d, logger)
+	graph := biz.NewGraphUsecase(follows, data.NewFollowRequestRepo(d, logger), data.NewBlockRepo(d, logger), data.NewMuteRepo(d, logger), users, tx, pages, logger)
+	sessions := biz.NewSessions(data.NewSessionRepo(d, logger), refreshTokens, data.NewSessionCache(d), tokens, logger)
+	resets := biz.NewPasswordResetUsecase(ac, data.NewPasswordResetRepo(d, logger), users, data.NewCredentialRepo(d, logger), sessions, biz.NewPasswordHasher(ac), mailer, tx, logger)
+	s := NewJobServer(&conf.Server{Job: &conf.Server_Job{ReconcileCountsInterval: durationpb.New(10 * time.Millisecond)}}, graph, resets, logger)
+	started := make(chan error, 1)
+	go func() { started <- s.Start(ctx) }()
+
+	want := map[int64][2]int64{ids[0]: {1, 0}, ids[1]: {0, 1}}
+	deadline := time.Now().Add(5 * time.Second)
+	for repaired := false; !repaired; {
+		if time.Now().After(deadline) {
+			t.Fatal("the counts were not reconciled")
+		}
+		time.Sleep(10 * time.Millisecond)
+		repaired = true
+		for id, counts := range want {
+			u, err := users.FindByID(ctx, id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			repaired = repaired && u.FollowerCount == counts[0] && u.FollowingCount == counts[1]
+		}
+	}
+
+	stopCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := s.Stop(stopCtx); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+	if err := <-started; err != nil {
+		t.Errorf("Start() error = %v", err)
+	}
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1 "user/api/user/v1"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware/validate"
+)
+
+func TestValidateProfile(t *testing.T) {
+	handler := validate.Validator()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	tests := []struct {
+		name    string
+		profile *v1.Profile
+		// field is the invalid field, empty if the profile is valid.
+		field string
+	}{
+		{"empty", &v1.Profile{}, ""},
+		{"valid", &v1.Profile{
+			DisplayName: "Jane", Bio: strings.Repeat("é", 300), AvatarUrl: "https://example.com/a.png", Website: "http://example.com",
+			BirthDate: "1990-01-31", Locale: "en-US", Timezone: "Europe/Paris",
+		}, ""},
+		{"long display name", &v1.Profile{DisplayName: strings.Repeat("a", 51)}, "DisplayName"},
+		{"long bio", &v1.Profile{Bio: strings.Repeat("é", 301)}, "Bio"},
+		{"relative avatar url", &v1.Profile{AvatarUrl: "/a.png"}, "AvatarUrl"},
+		{"long website", &v1.Profile{Website: "https://example.com/" + strings.Repeat("a", 200)}, "Website"},
+		{"long location", &v1.Profile{Location: strings.Repeat("a", 101)}, "Location"},
+		{"birth date format", &v1.Profile{BirthDate: "31/01/1990"}, "BirthDate"},
+		{"locale", &v1.Profile{Locale: "en_US"}, "Locale"},
+		{"long timezone", &v1.Profile{Timezone: strings.Repeat("a", 65)}, "Timezone"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := handler(context.Background(), &v1.UpdateUserRequest{Id: 1, Profile: tt.profile})
+			if tt.field == "" {
+				if err != nil {
+					t.Errorf("Validator() error = %v", err)
+				}
+				return
+			}
+			se := errors.FromError(err)
+			if se.Code != 400 || se.Reason != "VALIDATOR" || !strings.Contains(se.Message, "Profile."+tt.field) {
+				t.Errorf("Validator() error = %v, want a validation error of Profile.%s", err, tt.field)
+			}
+		})
+	}
+}
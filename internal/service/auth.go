@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "user/api/user/v1"
+	"user/internal/biz"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AuthService is an authentication service.
+type AuthService struct {
+	v1.UnimplementedAuthServer
+
+	uc           *biz.AuthUsecase
+	verification *biz.VerificationUsecase
+	resets       *biz.PasswordResetUsecase
+	federation   *biz.FederationUsecase
+}
+
+// NewAuthService new an authentication service.
+func NewAuthService(uc *biz.AuthUsecase, verification *biz.VerificationUsecase, resets *biz.PasswordResetUsecase, federation *biz.FederationUsecase) *AuthService {
+	return &AuthService{uc: uc, verification: verification, resets: resets, federation: federation}
+}
+
+// Register implements user.AuthServer.
+func (s *AuthService) Register(ctx context.Context, in *v1.RegisterRequest) (*v1.RegisterReply, error) {
+	u, err := s.uc.Register(ctx, &biz.User{Username: in.Username, Email: in.Email}, in.Password)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RegisterReply{User: toUserInfo(u)}, nil
+}
+
+// Login implements user.AuthServer.
+func (s *AuthService) Login(ctx context.Context, in *v1.LoginRequest) (*v1.LoginReply, error) {
+	u, t, c, err := s.uc.Login(ctx, in.Email, in.Password)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		return &v1.LoginReply{SecondFactorRequired: true, ChallengeToken: c.Token}, nil
+	}
+	return &v1.LoginReply{
+		User:         toUserInfo(u),
+		AccessToken:  t.AccessToken.Token,
+		TokenType:    tokenType,
+		ExpiresIn:    expiresIn(t.AccessToken),
+		RefreshToken: t.RefreshToken,
+		SessionId:    t.SessionID,
+	}, nil
+}
+
+// StartFederatedLogin implements user.AuthServer.
+func (s *AuthService) StartFederatedLogin(ctx context.Context, in *v1.StartFederatedLoginRequest) (*v1.StartFederatedLoginReply, error) {
+	to, state, err := s.federation.StartLogin(ctx, in.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.StartFederatedLoginReply{AuthorizationUrl: to, State: state}, nil
+}
+
+// FinishFederatedLogin implements user.AuthServer.
+func (s *AuthService) FinishFederatedLogin(ctx context.Context, in *v1.FinishFederatedLoginRequest) (*v1.FinishFederatedLoginReply, error) {
+	u, t, c, created, err := s.federation.FinishLogin(ctx, in.Provider, in.Code, in.State, in.Error)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		return &v1.FinishFederatedLoginReply{SecondFactorRequired: true, ChallengeToken: c.Token, Created: created}, nil
+	}
+	return &v1.FinishFederatedLoginReply{
+		User:         toUserInfo(u),
+		AccessToken:  t.AccessToken.Token,
+		TokenType:    tokenType,
+		ExpiresIn:    expiresIn(t.AccessToken),
+		RefreshToken: t.RefreshToken,
+		SessionId:    t.SessionID,
+		Created:      created,
+	}, nil
+}
+
+// VerifySecondFactor implements user.AuthServer.
+func (s *AuthService) VerifySecondFactor(ctx context.Context, in *v1.VerifySecondFactorRequest) (*v1.VerifySecondFactorReply, error) {
+	u, t, err := s.uc.VerifySecondFactor(ctx, in.ChallengeToken, in.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.VerifySecondFactorReply{
+		User:         toUserInfo(u),
+		AccessToken:  t.AccessToken.Token,
+		TokenType:    tokenType,
+		ExpiresIn:    expiresIn(t.AccessToken),
+		RefreshToken: t.RefreshToken,
+		SessionId:    t.SessionID,
+	}, nil
+}
+
+// RefreshToken implements user.AuthServer.
+func (s *AuthService) RefreshToken(ctx context.Context, in *v1.RefreshTokenRequest) (*v1.RefreshTokenReply, error) {
+	t, err := s.uc.RefreshToken(ctx, in.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RefreshTokenReply{
+		AccessToken:  t.AccessToken.Token,
+		TokenType:    tokenType,
+		ExpiresIn:    expiresIn(t.AccessToken),
+		RefreshToken: t.RefreshToken,
+		SessionId:    t.SessionID,
+	}, nil
+}
+
+// ListLinkedIdentities implements user.AuthServer.
+func (s *AuthService) ListLinkedIdentities(ctx context.Context, in *v1.ListLinkedIdentitiesRequest) (*v1.ListLinkedIdentitiesReply, error) {
+	ids, err := s.federation.ListIdentities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListLinkedIdentitiesReply{Identities: make([]*v1.LinkedIdentity, 0, len(ids))}
+	for _, li := range ids {
+		reply.Identities = append(reply.Identities, &v1.LinkedIdentity{
+			Provider:      li.Provider,
+			Subject:       li.Subject,
+			Email:         li.Email,
+			CreateTime:    timestamppb.New(li.CreatedAt),
+			LastLoginTime: timestamppb.New(li.LastLoginAt),
+		})
+	}
+	return reply, nil
+}
+
+// UnlinkIdentity implements user.AuthServer.
+func (s *AuthService) UnlinkIdentity(ctx context.Context, in *v1.UnlinkIdentityRequest) (*v1.UnlinkIdentityReply, error) {
+	if err := s.federation.Unlink(ctx, in.Provider); err != nil {
+		return nil, err
+	}
+	return &v1.UnlinkIdentityReply{}, nil
+}
+
+// CreateAPIKey implements user.AuthServer.
+func (s *AuthService) CreateAPIKey(ctx context.Context, in *v1.CreateAPIKeyRequest) (*v1.CreateAPIKeyReply, error) {
+	k := &biz.APIKey{Name: in.Name, Scopes: in.Scopes}
+	if in.ExpireTime != nil {
+		t := in.ExpireTime.AsTime()
+		k.ExpiresAt = &t
+	}
+	nk, key, err := s.uc.CreateAPIKey(ctx, k)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateAPIKeyReply{ApiKey: toAPIKey(nk), Key: key}, nil
+}
+
+// ListAPIKeys implements user.AuthServer.
+func (s *AuthService) ListAPIKeys(ctx context.Context, in *v1.ListAPIKeysRequest) (*v1.ListAPIKeysReply, error) {
+	ks, err := s.uc.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListAPIKeysReply{ApiKeys: make([]*v1.APIKey, 0, len(ks))}
+	for _, k := range ks {
+		reply.ApiKeys = append(reply.ApiKeys, toAPIKey(k))
+	}
+	return reply, nil
+}
+
+// RevokeAPIKey implements user.AuthServer.
+func (s *AuthService) RevokeAPIKey(ctx context.Context, in *v1.RevokeAPIKeyRequest) (*v1.RevokeAPIKeyReply, error) {
+	if err := s.uc.RevokeAPIKey(ctx, in.Id); err != nil {
+		return nil, err
+	}
+	return &v1.RevokeAPIKeyReply{}, nil
+}
+
+func toAPIKey(k *biz.APIKey) *v1.APIKey {
+	ak := &v1.APIKey{
+		Id:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		CreateTime: timestamppb.New(k.CreatedAt),
+	}
+	if k.ExpiresAt != nil {
+		ak.ExpireTime = timestamppb.New(*k.ExpiresAt)
+	}
+	if k.LastUsedAt != nil {
+		ak.LastUsedTime = timestamppb.New(*k.LastUsedAt)
+	}
+	return ak
+}
+
+// ListSessions implements user.AuthServer.
+func (s *AuthService) ListSessions(ctx context.Context, in *v1.ListSessionsRequest) (*v1.ListSessionsReply, error) {
+	ss, current, err := s.uc.ListSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListSessionsReply{Sessions: make([]*v1.Session, 0, len(ss))}
+	for _, ses := range ss {
+		reply.Sessions = append(reply.Sessions, &v1.Session{
+			Id:           ses.ID,
+			Device:       ses.Device,
+			UserAgent:    ses.UserAgent,
+			Ip:           ses.IP,
+			CreateTime:   timestamppb.New(ses.CreatedAt),
+			LastSeenTime: timestamppb.New(ses.LastSeenAt),
+			ExpireTime:   timestamppb.New(ses.ExpiresAt),
+			Current:      ses.ID == current,
+			ClientId:     ses.ClientID,
+		})
+	}
+	return reply, nil
+}
+
+// RevokeSession implements user.AuthServer.
+func (s *AuthService) RevokeSession(ctx context.Context, in *v1.RevokeSessionRequest) (*v1.RevokeSessionReply, error) {
+	if err := s.uc.RevokeSession(ctx, in.SessionId); err != nil {
+		return nil, err
+	}
+	return &v1.RevokeSessionReply{}, nil
+}
+
+// RevokeAllOtherSessions implements user.AuthServer.
+func (s *AuthService) RevokeAllOtherSessions(ctx context.Context, in *v1.RevokeAllOtherSessionsRequest) (*v1.RevokeAllOtherSessionsReply, error) {
+	n, err := s.uc.RevokeAllOtherSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RevokeAllOtherSessionsReply{RevokedCount: int32(n)}, nil
+}
+
+// VerifyEmail implements user.AuthServer.
+func (s *AuthService) VerifyEmail(ctx context.Context, in *v1.VerifyEmailRequest) (*v1.VerifyEmailReply, error) {
+	u, err := s.verification.VerifyEmail(ctx, in.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.VerifyEmailReply{User: toUserInfo(u)}, nil
+}
+
+// ResendVerification implements user.AuthServer.
+func (s *AuthService) ResendVerification(ctx context.Context, in *v1.ResendVerificationRequest) (*v1.ResendVerificationReply, error) {
+	if err := s.verification.ResendVerification(ctx); err != nil {
+		return nil, err
+	}
+	return &v1.ResendVerificationReply{}, nil
+}
+
+// EnrollTOTP implements user.AuthServer.
+func (s *AuthService) EnrollTOTP(ctx context.Context, in *v1.EnrollTOTPRequest) (*v1.EnrollTOTPReply, error) {
+	secret, uri, err := s.uc.EnrollTOTP(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.EnrollTOTPReply{Secret: secret, OtpauthUri: uri}, nil
+}
+
+// ConfirmTOTP implements user.AuthServer.
+func (s *AuthService) ConfirmTOTP(ctx context.Context, in *v1.ConfirmTOTPRequest) (*v1.ConfirmTOTPReply, error) {
+	codes, err := s.uc.ConfirmTOTP(ctx, in.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ConfirmTOTPReply{RecoveryCodes: codes}, nil
+}
+
+// DisableTOTP implements user.AuthServer.
+func (s *AuthService) DisableTOTP(ctx context.Context, in *v1.DisableTOTPRequest) (*v1.DisableTOTPReply, error) {
+	if err := s.uc.DisableTOTP(ctx, in.Code); err != nil {
+		return nil, err
+	}
+	return &v1.DisableTOTPReply{}, nil
+}
+
+// RequestPasswordReset implements user.AuthServer.
+func (s *AuthService) RequestPasswordReset(ctx context.Context, in *v1.RequestPasswordResetRequest) (*v1.RequestPasswordResetReply, error) {
+	s.resets.RequestPasswordReset(ctx, in.Email)
+	return &v1.RequestPasswordResetReply{}, nil
+}
+
+// ConfirmPasswordReset implements user.AuthServer.
+func (s *AuthService) ConfirmPasswordReset(ctx context.Context, in *v1.ConfirmPasswordResetRequest) (*v1.ConfirmPasswordResetReply, error) {
+	if err := s.resets.ConfirmPasswordReset(ctx, in.Token, in.NewPassword); err != nil {
+		return nil, err
+	}
+	return &v1.ConfirmPasswordResetReply{}, nil
+}
+
+// tokenType is the OAuth 2.0 type of the access tokens.
+const tokenType = "Bearer"
+
+// expiresIn returns the remaining lifetime of t in seconds.
+func expiresIn(t *biz.AccessToken) int64 {
+	return int64(time.Until(t.ExpiresAt).Round(time.Second).Seconds())
+}
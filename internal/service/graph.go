@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+
+	v1 "user/api/user/v1"
+	"user/internal/biz"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GraphService is a social graph service.
+type GraphService struct {
+	v1.UnimplementedGraphServer
+
+	uc *biz.GraphUsecase
+}
+
+// NewGraphService new a social graph service.
+func NewGraphService(uc *biz.GraphUsecase) *GraphService {
+	return &GraphService{uc: uc}
+}
+
+// Follow implements user.GraphServer.
+func (s *GraphService) Follow(ctx context.Context, in *v1.FollowRequest) (*v1.FollowReply, error) {
+	f, pending, err := s.uc.Follow(ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.FollowReply{Follow: toFollowInfo(f.FolloweeID, f), Pending: pending}, nil
+}
+
+// Unfollow implements user.GraphServer.
+func (s *GraphService) Unfollow(ctx context.Context, in *v1.UnfollowRequest) (*v1.UnfollowReply, error) {
+	if err := s.uc.Unfollow(ctx, in.UserId); err != nil {
+		return nil, err
+	}
+	return &v1.UnfollowReply{}, nil
+}
+
+// ListFollowers implements user.GraphServer.
+func (s *GraphService) ListFollowers(ctx context.Context, in *v1.ListFollowersRequest) (*v1.ListFollowersReply, error) {
+	fs, next, err := s.uc.ListFollowers(ctx, in.UserId, biz.PageRequest{Token: in.PageToken, Size: int(in.PageSize)})
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListFollowersReply{Followers: make([]*v1.FollowInfo, 0, len(fs)), NextPageToken: next}
+	for _, f := range fs {
+		reply.Followers = append(reply.Followers, toFollowInfo(f.FollowerID, f))
+	}
+	return reply, nil
+}
+
+// ListFollowing implements user.GraphServer.
+func (s *GraphService) ListFollowing(ctx context.Context, in *v1.ListFollowingRequest) (*v1.ListFollowingReply, error) {
+	fs, next, err := s.uc.ListFollowing(ctx, in.UserId, biz.PageRequest{Token: in.PageToken, Size: int(in.PageSize)})
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListFollowingReply{Following: make([]*v1.FollowInfo, 0, len(fs)), NextPageToken: next}
+	for _, f := range fs {
+		reply.Following = append(reply.Following, toFollowInfo(f.FolloweeID, f))
+	}
+	return reply, nil
+}
+
+// IsFollowing implements user.GraphServer.
+func (s *GraphService) IsFollowing(ctx context.Context, in *v1.IsFollowingRequest) (*v1.IsFollowingReply, error) {
+	ok, err := s.uc.IsFollowing(ctx, in.UserId, in.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.IsFollowingReply{Following: ok}, nil
+}
+
+// toFollowInfo converts f to the FollowInfo of the user on the other side,
+// userID.
+func toFollowInfo(userID int64, f *biz.Follow) *v1.FollowInfo {
+	return &v1.FollowInfo{UserId: userID, FollowTime: timestamppb.New(f.CreatedAt)}
+}
+
+// ListFollowRequests implements user.GraphServer.
+func (s *GraphService) ListFollowRequests(ctx context.Context, in *v1.ListFollowRequestsRequest) (*v1.ListFollowRequestsReply, error) {
+	rs, next, err := s.uc.ListFollowRequests(ctx, biz.PageRequest{Token: in.PageToken, Size: int(in.PageSize)})
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListFollowRequestsReply{Requests: make([]*v1.FollowRequestInfo, 0, len(rs)), NextPageToken: next}
+	for _, r := range rs {
+		reply.Requests = append(reply.Requests, &v1.FollowRequestInfo{
+			RequesterId: r.RequesterID,
+			CreateTime:  timestamppb.New(r.CreatedAt),
+			ExpireTime:  timestamppb.New(r.ExpiresAt),
+		})
+	}
+	return reply, nil
+}
+
+// ApproveFollowRequest implements user.GraphServer.
+func (s *GraphService) ApproveFollowRequest(ctx context.Context, in *v1.ApproveFollowRequestRequest) (*v1.ApproveFollowRequestReply, error) {
+	f, err := s.uc.ApproveFollowRequest(ctx, in.RequesterId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ApproveFollowRequestReply{Follow: toFollowInfo(f.FollowerID, f)}, nil
+}
+
+// RejectFollowRequest implements user.GraphServer.
+func (s *GraphService) RejectFollowRequest(ctx context.Context, in *v1.RejectFollowRequestRequest) (*v1.RejectFollowRequestReply, error) {
+	if err := s.uc.RejectFollowRequest(ctx, in.RequesterId); err != nil {
+		return nil, err
+	}
+	return &v1.RejectFollowRequestReply{}, nil
+}
+
+// Block implements user.GraphServer.
+func (s *GraphService) Block(ctx context.Context, in *v1.BlockRequest) (*v1.BlockReply, error) {
+	if err := s.uc.Block(ctx, in.UserId); err != nil {
+		return nil, err
+	}
+	return &v1.BlockReply{}, nil
+}
+
+// Unblock implements user.GraphServer.
+func (s *GraphService) Unblock(ctx context.Context, in *v1.UnblockRequest) (*v1.UnblockReply, error) {
+	if err := s.uc.Unblock(ctx, in.UserId); err != nil {
+		return nil, err
+	}
+	return &v1.UnblockReply{}, nil
+}
+
+// Mute implements user.GraphServer.
+func (s *GraphService) Mute(ctx context.Context, in *v1.MuteRequest) (*v1.MuteReply, error) {
+	if err := s.uc.Mute(ctx, in.UserId); err != nil {
+		return nil, err
+	}
+	return &v1.MuteReply{}, nil
+}
+
+// Unmute implements user.GraphServer.
+func (s *GraphService) Unmute(ctx context.Context, in *v1.UnmuteRequest) (*v1.UnmuteReply, error) {
+	if err := s.uc.Unmute(ctx, in.UserId); err != nil {
+		return nil, err
+	}
+	return &v1.UnmuteReply{}, nil
+}
+
+// CheckVisibility implements user.GraphServer.
+func (s *GraphService) CheckVisibility(ctx context.Context, in *v1.CheckVisibilityRequest) (*v1.CheckVisibilityReply, error) {
+	v, err := s.uc.CheckVisibility(ctx, in.ViewerId, in.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CheckVisibilityReply{
+		Visible:  v.Visible,
+		Blocked:  v.Blocked,
+		Blocking: v.Blocking,
+		Muted:    v.Muted,
+	}, nil
+}
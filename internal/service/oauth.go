@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	v1 "user/api/user/v1"
+	"user/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// OAuthService is the OAuth2 and OpenID Connect authorization server: the
+// OAuthAdmin RPCs managing its clients, and the plain HTTP endpoints of
+// RFC 6749 and OpenID Connect Discovery.
+type OAuthService struct {
+	v1.UnimplementedOAuthAdminServer
+
+	uc *biz.OAuthUsecase
+}
+
+// NewOAuthService new an OAuthService.
+func NewOAuthService(uc *biz.OAuthUsecase) *OAuthService {
+	return &OAuthService{uc: uc}
+}
+
+// CreateOAuthClient implements user.OAuthAdminServer.
+func (s *OAuthService) CreateOAuthClient(ctx context.Context, in *v1.CreateOAuthClientRequest) (*v1.CreateOAuthClientReply, error) {
+	c, secret, err := s.uc.CreateClient(ctx, &biz.OAuthClient{
+		Name:         in.Name,
+		RedirectURIs: in.RedirectUris,
+		GrantTypes:   in.GrantTypes,
+		Scopes:       in.Scopes,
+	}, in.Public)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateOAuthClientReply{Client: toOAuthClient(c), ClientSecret: secret}, nil
+}
+
+// GetOAuthClient implements user.OAuthAdminServer.
+func (s *OAuthService) GetOAuthClient(ctx context.Context, in *v1.GetOAuthClientRequest) (*v1.GetOAuthClientReply, error) {
+	c, err := s.uc.GetClient(ctx, in.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetOAuthClientReply{Client: toOAuthClient(c)}, nil
+}
+
+// ListOAuthClients implements user.OAuthAdminServer.
+func (s *OAuthService) ListOAuthClients(ctx context.Context, in *v1.ListOAuthClientsRequest) (*v1.ListOAuthClientsReply, error) {
+	cs, next, err := s.uc.ListClients(ctx, biz.PageRequest{Token: in.PageToken, Size: int(in.PageSize)})
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListOAuthClientsReply{Clients: make([]*v1.OAuthClient, 0, len(cs)), NextPageToken: next}
+	for _, c := range cs {
+		reply.Clients = append(reply.Clients, toOAuthClient(c))
+	}
+	return reply, nil
+}
+
+// DeleteOAuthClient implements user.OAuthAdminServer.
+func (s *OAuthService) DeleteOAuthClient(ctx context.Context, in *v1.DeleteOAuthClientRequest) (*v1.DeleteOAuthClientReply, error) {
+	if err := s.uc.DeleteClient(ctx, in.ClientId); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteOAuthClientReply{}, nil
+}
+
+func toOAuthClient(c *biz.OAuthClient) *v1.OAuthClient {
+	return &v1.OAuthClient{
+		ClientId:     c.ClientID,
+		Name:         c.Name,
+		Public:       c.Public(),
+		RedirectUris: c.RedirectURIs,
+		GrantTypes:   c.GrantTypes,
+		Scopes:       c.Scopes,
+		CreateTime:   timestamppb.New(c.CreatedAt),
+	}
+}
+
+// Discovery serves the OpenID Provider Metadata.
+func (s *OAuthService) Discovery(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet) {
+		return
+	}
+	iss := s.uc.Issuer()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                iss,
+		"authorization_endpoint":                iss + "/oauth2/authorize",
+		"token_endpoint":                        iss + "/oauth2/token",
+		"userinfo_endpoint":                     iss + "/oauth2/userinfo",
+		"jwks_uri":                              iss + "/oauth2/jwks",
+		"response_types_supported":              []string{"code"},
+		"response_modes_supported":              []string{"query"},
+		"grant_types_supported":                 []string{biz.GrantAuthorizationCode, biz.GrantRefreshToken, biz.GrantClientCredentials},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{s.uc.SigningAlgorithm()},
+		"scopes_supported":                      []string{"openid", "profile", "email"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+		"code_challenge_methods_supported":      []string{"S256"},
+		"claims_supported": []string{
+			"iss", "sub", "aud", "exp", "iat", "azp", "nonce", "email", "email_verified", "preferred_username",
+			"name", "picture", "website", "locale", "zoneinfo", "birthdate", "updated_at",
+		},
+	})
+}
+
+// JWKS serves the public keys verifying the tokens of the server.
+func (s *OAuthService) JWKS(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet) {
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"keys": s.uc.JWKS()})
+}
+
+// Authorize serves the authorization endpoint. GET sends the user agent to
+// the login page, which then POSTs the same parameters with the access
+// token of the user, and is replied where to send the user agent.
+func (s *OAuthService) Authorize(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet, http.MethodPost) {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeOAuthError(w, r, biz.InvalidOAuthRequest("malformed request"))
+		return
+	}
+	req := &biz.AuthorizationRequest{
+		ResponseType:        r.Form.Get("response_type"),
+		ClientID:            r.Form.Get("client_id"),
+		RedirectURI:         r.Form.Get("redirect_uri"),
+		Scope:               r.Form.Get("scope"),
+		State:               r.Form.Get("state"),
+		Nonce:               r.Form.Get("nonce"),
+		CodeChallenge:       r.Form.Get("code_challenge"),
+		CodeChallengeMethod: r.Form.Get("code_challenge_method"),
+	}
+	if r.Method == http.MethodGet {
+		to, err := s.uc.StartAuthorization(r.Context(), req, r.URL.RawQuery)
+		if err != nil {
+			writeOAuthError(w, r, err)
+			return
+		}
+		http.Redirect(w, r, to, http.StatusFound)
+		return
+	}
+	to, err := s.uc.Authorize(r.Context(), req)
+	if err != nil {
+		writeOAuthError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"redirect_to": to})
+}
+
+// Token serves the token endpoint.
+func (s *OAuthService) Token(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodPost) {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeOAuthError(w, r, biz.InvalidOAuthRequest("malformed request"))
+		return
+	}
+	req := &biz.TokenRequest{
+		GrantType:    r.PostForm.Get("grant_type"),
+		ClientID:     r.PostForm.Get("client_id"),
+		ClientSecret: r.PostForm.Get("client_secret"),
+		Code:         r.PostForm.Get("code"),
+		RedirectURI:  r.PostForm.Get("redirect_uri"),
+		CodeVerifier: r.PostForm.Get("code_verifier"),
+		RefreshToken: r.PostForm.Get("refresh_token"),
+		Scope:        r.PostForm.Get("scope"),
+	}
+	if id, secret, ok := r.BasicAuth(); ok {
+		if req.ClientSecret != "" {
+			writeOAuthError(w, r, biz.InvalidOAuthRequest("more than one client authentication method"))
+			return
+		}
+		// The credentials are form encoded before the basic encoding.
+		req.ClientID, _ = url.QueryUnescape(id)
+		req.ClientSecret, _ = url.QueryUnescape(secret)
+	}
+	resp, err := s.uc.Token(r.Context(), req)
+	if err != nil {
+		writeOAuthError(w, r, err)
+		return
+	}
+	reply := map[string]interface{}{
+		"access_token": resp.AccessToken.Token,
+		"token_type":   "Bearer",
+		"expires_in":   int64(time.Until(resp.AccessToken.ExpiresAt).Seconds()),
+	}
+	if resp.RefreshToken != "" {
+		reply["refresh_token"] = resp.RefreshToken
+	}
+	if resp.IDToken != "" {
+		reply["id_token"] = resp.IDToken
+	}
+	if resp.Scope != "" {
+		reply["scope"] = resp.Scope
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	writeJSON(w, http.StatusOK, reply)
+}
+
+// UserInfo serves the claims about the user of an access token granted the
+// openid scope.
+func (s *OAuthService) UserInfo(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet, http.MethodPost) {
+		return
+	}
+	claims, err := s.uc.UserInfo(r.Context())
+	switch {
+	case errors.Is(err, biz.ErrUnauthenticated):
+		w.Header().Set("WWW-Authenticate", `Bearer`)
+		khttp.DefaultErrorEncoder(w, r, err)
+	case errors.Is(err, biz.ErrPermissionDenied):
+		w.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope", scope="openid"`)
+		khttp.DefaultErrorEncoder(w, r, err)
+	case err != nil:
+		khttp.DefaultErrorEncoder(w, r, err)
+	default:
+		writeJSON(w, http.StatusOK, claims)
+	}
+}
+
+// allowMethods replies 405 to requests of other methods, and reports
+// whether r is of one of methods.
+func allowMethods(w http.ResponseWriter, r *http.Request, methods ...string) bool {
+	for _, m := range methods {
+		if r.Method == m {
+			return true
+		}
+	}
+	for _, m := range methods {
+		w.Header().Add("Allow", m)
+	}
+	w.WriteHeader(http.StatusMethodNotAllowed)
+	return false
+}
+
+// writeOAuthError replies an OAuthError as of RFC 6749, and other errors as
+// the other endpoints of the server do.
+func writeOAuthError(w http.ResponseWriter, r *http.Request, err error) {
+	var oe *biz.OAuthError
+	if !errors.As(err, &oe) {
+		khttp.DefaultErrorEncoder(w, r, err)
+		return
+	}
+	if oe.Status() == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", `Basic realm="oauth2"`)
+	}
+	writeJSON(w, oe.Status(), map[string]string{"error": oe.Code, "error_description": oe.Description})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
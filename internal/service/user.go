@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+
+	v1 "user/api/user/v1"
+	"user/internal/biz"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UserService is a user service.
+type UserService struct {
+	v1.UnimplementedUserServer
+
+	uc *biz.UserUsecase
+}
+
+// NewUserService new a user service.
+func NewUserService(uc *biz.UserUsecase) *UserService {
+	return &UserService{uc: uc}
+}
+
+// GetUser implements user.UserServer.
+func (s *UserService) GetUser(ctx context.Context, in *v1.GetUserRequest) (*v1.GetUserReply, error) {
+	u, err := s.uc.GetUser(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetUserReply{User: toUserInfoFor(ctx, u)}, nil
+}
+
+// UpdateUser implements user.UserServer.
+func (s *UserService) UpdateUser(ctx context.Context, in *v1.UpdateUserRequest) (*v1.UpdateUserReply, error) {
+	u := &biz.User{ID: in.Id, Username: in.Username, Email: in.Email, Private: in.Private.GetValue(), Version: in.Version}
+	if in.Profile != nil {
+		p, err := fromProfile(in.Profile)
+		if err != nil {
+			return nil, err
+		}
+		u.Profile = *p
+	}
+	paths := in.UpdateMask.GetPaths()
+	if len(paths) == 0 {
+		paths = setUserPaths(in)
+	}
+	u, err := s.uc.UpdateUser(ctx, u, paths)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UpdateUserReply{User: toUserInfoFor(ctx, u)}, nil
+}
+
+// setUserPaths returns the update mask of an update without one: the paths
+// of the fields set to a non-empty value.
+func setUserPaths(in *v1.UpdateUserRequest) []string {
+	var paths []string
+	for _, f := range []struct {
+		path string
+		set  bool
+	}{
+		{biz.PathUsername, in.Username != ""},
+		{biz.PathEmail, in.Email != ""},
+		{biz.PathPrivate, in.Private != nil},
+		{biz.PathProfileDisplayName, in.Profile.GetDisplayName() != ""},
+		{biz.PathProfileBio, in.Profile.GetBio() != ""},
+		{biz.PathProfileAvatarURL, in.Profile.GetAvatarUrl() != ""},
+		{biz.PathProfileLocation, in.Profile.GetLocation() != ""},
+		{biz.PathProfileWebsite, in.Profile.GetWebsite() != ""},
+		{biz.PathProfileBirthDate, in.Profile.GetBirthDate() != ""},
+		{biz.PathProfileLocale, in.Profile.GetLocale() != ""},
+		{biz.PathProfileTimezone, in.Profile.GetTimezone() != ""},
+	} {
+		if f.set {
+			paths = append(paths, f.path)
+		}
+	}
+	return paths
+}
+
+// DeleteUser implements user.UserServer.
+func (s *UserService) DeleteUser(ctx context.Context, in *v1.DeleteUserRequest) (*v1.DeleteUserReply, error) {
+	if err := s.uc.DeleteUser(ctx, in.Id, in.Version); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteUserReply{}, nil
+}
+
+// ChangeHandle implements user.UserServer.
+func (s *UserService) ChangeHandle(ctx context.Context, in *v1.ChangeHandleRequest) (*v1.ChangeHandleReply, error) {
+	u, err := s.uc.ChangeHandle(ctx, in.Id, in.Handle)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ChangeHandleReply{User: toUserInfoFor(ctx, u)}, nil
+}
+
+// GetUserByHandle implements user.UserServer.
+func (s *UserService) GetUserByHandle(ctx context.Context, in *v1.GetUserByHandleRequest) (*v1.GetUserByHandleReply, error) {
+	u, redirected, err := s.uc.GetUserByHandle(ctx, in.Handle)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetUserByHandleReply{User: toUserInfoFor(ctx, u), Redirected: redirected}, nil
+}
+
+// BatchGetUsers implements user.UserServer.
+func (s *UserService) BatchGetUsers(ctx context.Context, in *v1.BatchGetUsersRequest) (*v1.BatchGetUsersReply, error) {
+	us, missing, err := s.uc.BatchGetUsers(ctx, in.Ids)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.BatchGetUsersReply{Users: make([]*v1.UserInfo, 0, len(us)), MissingIds: missing}
+	for _, u := range us {
+		reply.Users = append(reply.Users, toUserInfoFor(ctx, u))
+	}
+	return reply, nil
+}
+
+// BatchGetUsersByHandle implements user.UserServer.
+func (s *UserService) BatchGetUsersByHandle(ctx context.Context, in *v1.BatchGetUsersByHandleRequest) (*v1.BatchGetUsersByHandleReply, error) {
+	us, missing, err := s.uc.BatchGetUsersByHandle(ctx, in.Handles)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.BatchGetUsersByHandleReply{Users: make([]*v1.UserByHandle, 0, len(us)), MissingHandles: missing}
+	for _, u := range us {
+		reply.Users = append(reply.Users, &v1.UserByHandle{Handle: u.Handle, User: toUserInfoFor(ctx, u.User), Redirected: u.Redirected})
+	}
+	return reply, nil
+}
+
+// ListUsers implements user.UserServer.
+func (s *UserService) ListUsers(ctx context.Context, in *v1.ListUsersRequest) (*v1.ListUsersReply, error) {
+	us, next, err := s.uc.ListUsers(ctx, biz.PageRequest{Token: in.PageToken, Size: int(in.PageSize)})
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListUsersReply{Users: make([]*v1.UserInfo, 0, len(us)), NextPageToken: next}
+	for _, u := range us {
+		reply.Users = append(reply.Users, toUserInfoFor(ctx, u))
+	}
+	return reply, nil
+}
+
+// toUserInfoFor returns the UserInfo of u as the caller of ctx may see it:
+// the email is only shown to the user themselves.
+func toUserInfoFor(ctx context.Context, u *biz.User) *v1.UserInfo {
+	info := toUserInfo(u)
+	if id, ok := biz.UserIDFromContext(ctx); !ok || id != u.ID {
+		info.Email, info.EmailVerified = "", false
+	}
+	return info
+}
+
+// toUserInfo returns the UserInfo of u, email included, for replies to the
+// user themselves.
+func toUserInfo(u *biz.User) *v1.UserInfo {
+	return &v1.UserInfo{
+		Id:             u.ID,
+		Username:       u.Username,
+		Email:          u.Email,
+		EmailVerified:  u.EmailVerified,
+		Private:        u.Private,
+		Profile:        toProfile(&u.Profile),
+		Version:        u.Version,
+		CreateTime:     timestamppb.New(u.CreatedAt),
+		UpdateTime:     timestamppb.New(u.UpdatedAt),
+		FollowerCount:  u.FollowerCount,
+		FollowingCount: u.FollowingCount,
+	}
+}
+
+func toProfile(p *biz.Profile) *v1.Profile {
+	var birthDate string
+	if !p.BirthDate.IsZero() {
+		birthDate = p.BirthDate.Format(biz.BirthDateLayout)
+	}
+	return &v1.Profile{
+		DisplayName: p.DisplayName,
+		Bio:         p.Bio,
+		AvatarUrl:   p.AvatarURL,
+		Location:    p.Location,
+		Website:     p.Website,
+		BirthDate:   birthDate,
+		Locale:      p.Locale,
+		Timezone:    p.Timezone,
+	}
+}
+
+func fromProfile(p *v1.Profile) (*biz.Profile, error) {
+	birthDate, err := biz.ParseBirthDate(p.BirthDate)
+	if err != nil {
+		return nil, err
+	}
+	return &biz.Profile{
+		DisplayName: p.DisplayName,
+		Bio:         p.Bio,
+		AvatarURL:   p.AvatarUrl,
+		Location:    p.Location,
+		Website:     p.Website,
+		BirthDate:   birthDate,
+		Locale:      p.Locale,
+		Timezone:    p.Timezone,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	v1 "user/api/user/v1"
+	"user/internal/biz"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestToUserInfoForHidesEmail(t *testing.T) {
+	u := &biz.User{ID: 1, Username: "jane", Email: "jane@example.com", EmailVerified: true}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"self", biz.NewPrincipalContext(context.Background(), &biz.Principal{UserID: 1}), u.Email},
+		{"other user", biz.NewPrincipalContext(context.Background(), &biz.Principal{UserID: 2}), ""},
+		{"anonymous", context.Background(), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := toUserInfoFor(tt.ctx, u)
+			if info.Email != tt.want || info.EmailVerified != (tt.want != "") {
+				t.Errorf("toUserInfoFor() email = %q, %t, want %q", info.Email, info.EmailVerified, tt.want)
+			}
+			if info.Username != u.Username {
+				t.Errorf("toUserInfoFor() username = %q, want %q", info.Username, u.Username)
+			}
+		})
+	}
+}
+
+func TestSetUserPaths(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *v1.UpdateUserRequest
+		want []string
+	}{
+		{"nothing", &v1.UpdateUserRequest{Id: 1}, nil},
+		{"bio", &v1.UpdateUserRequest{Id: 1, Profile: &v1.Profile{Bio: "hi"}}, []string{biz.PathProfileBio}},
+		{"private false", &v1.UpdateUserRequest{Id: 1, Private: wrapperspb.Bool(false)}, []string{biz.PathPrivate}},
+		{
+			"handle, email and profile",
+			&v1.UpdateUserRequest{Id: 1, Username: "jane", Email: "jane@example.com", Profile: &v1.Profile{DisplayName: "Jane", Timezone: "UTC"}},
+			[]string{biz.PathUsername, biz.PathEmail, biz.PathProfileDisplayName, biz.PathProfileTimezone},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := setUserPaths(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("setUserPaths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}